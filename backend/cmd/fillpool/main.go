@@ -0,0 +1,64 @@
+// Command fillpool pre-generates cases into the case pool
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/ttrubel/send-me-home/internal/casepool"
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/services/firestore"
+	"github.com/ttrubel/send-me-home/internal/services/llm"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+	"github.com/ttrubel/send-me-home/internal/voices"
+)
+
+func main() {
+	cfg := config.Load()
+
+	ruleSets := flag.Int("rulesets", cfg.CasePoolRuleSets, "rule sets to keep in the pool")
+	size := flag.Int("size", cfg.CasePoolSize, "cases per rule set and difficulty")
+	difficulty := flag.String("difficulty", "", "only fill this difficulty (easy, normal or hard)")
+	flag.Parse()
+
+	difficulties := casepool.Difficulties
+	if *difficulty != "" {
+		difficulties = []models.Difficulty{models.Difficulty(*difficulty)}
+	}
+
+	firestoreClient, err := firestore.NewClient(cfg.GCPProjectID)
+	if err != nil {
+		log.Fatalf("Failed to initialize Firestore: %v", err)
+	}
+	defer firestoreClient.Close()
+
+	voiceRegistry, err := voices.Load(cfg.VoicesFile)
+	if err != nil {
+		log.Fatalf("Failed to load voices: %v", err)
+	}
+
+	completer, err := llm.NewCompleter(cfg)
+	if err != nil {
+		log.Fatalf("Invalid LLM configuration: %v", err)
+	}
+
+	lex, err := lexicon.Load(cfg.LexiconFile)
+	if err != nil {
+		log.Fatalf("Failed to load pronunciation lexicon: %v", err)
+	}
+
+	speech, err := tts.NewSynthesizer(cfg, voiceRegistry, lex)
+	if err != nil {
+		log.Fatalf("Invalid TTS configuration: %v", err)
+	}
+
+	filler := casepool.NewFiller(llm.NewContentGenerator(cfg, completer, voiceRegistry), speech, firestoreClient)
+	if err := filler.Fill(context.Background(), *ruleSets, *size, difficulties); err != nil {
+		log.Fatalf("Failed to fill case pool: %v", err)
+	}
+
+	log.Printf("Case pool filled: %d rule sets, %d cases per difficulty", *ruleSets, *size)
+}
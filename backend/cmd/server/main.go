@@ -2,11 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/rs/cors"
@@ -15,22 +20,38 @@ import (
 
 	"github.com/ttrubel/send-me-home/gen/game/v1/gamev1connect"
 	"github.com/ttrubel/send-me-home/internal/api"
+	"github.com/ttrubel/send-me-home/internal/budget"
+	"github.com/ttrubel/send-me-home/internal/casepool"
 	"github.com/ttrubel/send-me-home/internal/config"
-	"github.com/ttrubel/send-me-home/internal/services/elevenlabs"
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/scoring"
 	"github.com/ttrubel/send-me-home/internal/services/firestore"
-	"github.com/ttrubel/send-me-home/internal/services/gemini"
+	"github.com/ttrubel/send-me-home/internal/services/llm"
+	"github.com/ttrubel/send-me-home/internal/services/stt"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+	"github.com/ttrubel/send-me-home/internal/usage"
+	"github.com/ttrubel/send-me-home/internal/voices"
 )
 
 func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Shut down on SIGINT or SIGTERM. Background workers stop once requests
+	// have drained, so their final writes cover every call.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	workCtx, stopWork := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+
 	// Initialize services
-	// Gemini client reads config from environment variables:
-	// - GOOGLE_GENAI_USE_VERTEXAI=true for Vertex AI
-	// - GOOGLE_CLOUD_PROJECT and GOOGLE_CLOUD_LOCATION for Vertex AI
-	// - GOOGLE_API_KEY for AI Studio
-	geminiClient := gemini.NewClient()
+	voiceRegistry, err := voices.Load(cfg.VoicesFile)
+	if err != nil {
+		log.Fatalf("Failed to load voices: %v", err)
+	}
+	if cfg.VoicesFile != "" && cfg.VoicesReloadInterval > 0 {
+		go voiceRegistry.Watch(context.Background(), cfg.VoicesReloadInterval)
+	}
 
 	firestoreClient, err := firestore.NewClient(cfg.GCPProjectID)
 	if err != nil {
@@ -38,11 +59,56 @@ func main() {
 	}
 	defer firestoreClient.Close()
 
-	// Initialize ElevenLabs client
-	elevenlabsClient := elevenlabs.NewClient(cfg.ElevenLabsAPIKey)
+	// Cap AI spending; calls fall back to mock content and text-only
+	// dialogue when a budget runs out
+	spending := budget.New(budget.ConfiguredLimits(cfg), firestoreClient)
+	usage.Observe(spending.Record)
+	workers.Go(func() { spending.Run(workCtx, cfg.BudgetSyncInterval) })
+
+	completer, err := llm.NewCompleter(cfg)
+	if err != nil {
+		log.Fatalf("Invalid LLM configuration: %v", err)
+	}
+	content := llm.NewContentGenerator(cfg, spending.Completer(completer), voiceRegistry)
+
+	// Initialize speech synthesis
+	lex, err := lexicon.Load(cfg.LexiconFile)
+	if err != nil {
+		log.Fatalf("Failed to load pronunciation lexicon: %v", err)
+	}
+
+	speech, err := tts.NewSynthesizer(cfg, voiceRegistry, lex)
+	if err != nil {
+		log.Fatalf("Invalid TTS configuration: %v", err)
+	}
+	speech = spending.Synthesizer(speech)
+
+	// Initialize speech recognition for voice questions
+	transcriber, err := stt.NewTranscriber(cfg)
+	if err != nil {
+		log.Fatalf("Invalid STT configuration: %v", err)
+	}
+	transcriber = spending.Transcriber(transcriber, cfg.STTProvider)
+
+	// Initialize scoring engine
+	scorer, err := scoring.NewEngineFromNames(cfg.ScoringStrategies)
+	if err != nil {
+		log.Fatalf("Invalid scoring configuration: %v", err)
+	}
+
+	// Count AI usage per session and player
+	ledger := usage.NewLedger(firestoreClient)
+	workers.Go(func() { ledger.Run(workCtx, cfg.UsageFlushInterval) })
 
 	// Initialize handler
-	gameHandler := api.NewGameHandler(geminiClient, firestoreClient, elevenlabsClient)
+	gameHandler := api.NewGameHandler(cfg, content, firestoreClient, speech, transcriber, voiceRegistry, scorer, ledger, spending)
+
+	// Keep the case pool topped up in the background
+	if cfg.CasePool && cfg.CasePoolFillInterval > 0 {
+		filler := casepool.NewFiller(content, speech, firestoreClient)
+		go filler.Run(context.Background(), cfg.CasePoolFillInterval, cfg.CasePoolRuleSets, cfg.CasePoolSize, casepool.Difficulties)
+		log.Printf("Case pool worker filling every %s", cfg.CasePoolFillInterval)
+	}
 
 	// Create Connect-RPC service
 	mux := http.NewServeMux()
@@ -54,6 +120,9 @@ func main() {
 	)
 	mux.Handle(path, handler)
 
+	// Usage metrics for Prometheus
+	mux.Handle("/metrics", usage.MetricsHandler(usage.ConfiguredPrices(cfg)))
+
 	// Health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -104,6 +173,7 @@ func main() {
 			"Content-Type",
 			"Connect-Protocol-Version",
 			"Connect-Timeout-Ms",
+			"Authorization", // Bearer token for the admin RPCs
 		},
 		ExposedHeaders: []string{
 			"Connect-Protocol-Version",
@@ -119,9 +189,22 @@ func main() {
 	log.Printf("Server starting on port %s", cfg.Port)
 	log.Printf("Game service available at http://localhost:%s%s", cfg.Port, path)
 
-	if err := server.ListenAndServe(); err != nil {
-		log.Fatalf("Server failed: %v", err)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Server failed: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Printf("Shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Warning: Failed to drain requests: %v", err)
 	}
+	cancel()
+
+	stopWork()
+	workers.Wait()
 }
 
 // loggingInterceptor logs all RPC calls
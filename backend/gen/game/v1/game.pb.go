@@ -21,6 +21,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Audio codecs clients can ask for. Unspecified means MP3 at 128 kbps.
+// Servers without a codec fall back to one they have and report it in the
+// MIME type that comes with the audio.
+type AudioFormat int32
+
+const (
+	AudioFormat_AUDIO_FORMAT_UNSPECIFIED AudioFormat = 0
+	AudioFormat_AUDIO_FORMAT_MP3_64      AudioFormat = 1 // 44.1 kHz, 64 kbps
+	AudioFormat_AUDIO_FORMAT_MP3_128     AudioFormat = 2 // 44.1 kHz, 128 kbps
+	AudioFormat_AUDIO_FORMAT_MP3_192     AudioFormat = 3 // 44.1 kHz, 192 kbps
+	AudioFormat_AUDIO_FORMAT_OPUS        AudioFormat = 4 // Ogg Opus, 48 kHz, 64 kbps
+	AudioFormat_AUDIO_FORMAT_PCM_16000   AudioFormat = 5 // Raw 16-bit little-endian mono PCM at 16 kHz
+	AudioFormat_AUDIO_FORMAT_PCM_24000   AudioFormat = 6 // Raw 16-bit little-endian mono PCM at 24 kHz
+)
+
+// Enum value maps for AudioFormat.
+var (
+	AudioFormat_name = map[int32]string{
+		0: "AUDIO_FORMAT_UNSPECIFIED",
+		1: "AUDIO_FORMAT_MP3_64",
+		2: "AUDIO_FORMAT_MP3_128",
+		3: "AUDIO_FORMAT_MP3_192",
+		4: "AUDIO_FORMAT_OPUS",
+		5: "AUDIO_FORMAT_PCM_16000",
+		6: "AUDIO_FORMAT_PCM_24000",
+	}
+	AudioFormat_value = map[string]int32{
+		"AUDIO_FORMAT_UNSPECIFIED": 0,
+		"AUDIO_FORMAT_MP3_64":      1,
+		"AUDIO_FORMAT_MP3_128":     2,
+		"AUDIO_FORMAT_MP3_192":     3,
+		"AUDIO_FORMAT_OPUS":        4,
+		"AUDIO_FORMAT_PCM_16000":   5,
+		"AUDIO_FORMAT_PCM_24000":   6,
+	}
+)
+
+func (x AudioFormat) Enum() *AudioFormat {
+	p := new(AudioFormat)
+	*p = x
+	return p
+}
+
+func (x AudioFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudioFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_v1_game_proto_enumTypes[0].Descriptor()
+}
+
+func (AudioFormat) Type() protoreflect.EnumType {
+	return &file_game_v1_game_proto_enumTypes[0]
+}
+
+func (x AudioFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudioFormat.Descriptor instead.
+func (AudioFormat) EnumDescriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{0}
+}
+
 type CaseOutcome int32
 
 const (
@@ -60,11 +124,11 @@ func (x CaseOutcome) String() string {
 }
 
 func (CaseOutcome) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_v1_game_proto_enumTypes[0].Descriptor()
+	return file_game_v1_game_proto_enumTypes[1].Descriptor()
 }
 
 func (CaseOutcome) Type() protoreflect.EnumType {
-	return &file_game_v1_game_proto_enumTypes[0]
+	return &file_game_v1_game_proto_enumTypes[1]
 }
 
 func (x CaseOutcome) Number() protoreflect.EnumNumber {
@@ -73,7 +137,7 @@ func (x CaseOutcome) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CaseOutcome.Descriptor instead.
 func (CaseOutcome) EnumDescriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{0}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{1}
 }
 
 type Decision int32
@@ -112,11 +176,11 @@ func (x Decision) String() string {
 }
 
 func (Decision) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_v1_game_proto_enumTypes[1].Descriptor()
+	return file_game_v1_game_proto_enumTypes[2].Descriptor()
 }
 
 func (Decision) Type() protoreflect.EnumType {
-	return &file_game_v1_game_proto_enumTypes[1]
+	return &file_game_v1_game_proto_enumTypes[2]
 }
 
 func (x Decision) Number() protoreflect.EnumNumber {
@@ -125,13 +189,17 @@ func (x Decision) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Decision.Descriptor instead.
 func (Decision) EnumDescriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{1}
+	return file_game_v1_game_proto_rawDescGZIP(), []int{2}
 }
 
 type StartSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional: difficulty level, number of cases, etc.
-	NumCases      int32 `protobuf:"varint,1,opt,name=num_cases,json=numCases,proto3" json:"num_cases,omitempty"` // default: 15
+	NumCases      int32  `protobuf:"varint,1,opt,name=num_cases,json=numCases,proto3" json:"num_cases,omitempty"` // default: 15
+	Adaptive      bool   `protobuf:"varint,2,opt,name=adaptive,proto3" json:"adaptive,omitempty"`                 // Generate upcoming cases lazily, tuned to player accuracy
+	PlayerId      string `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`  // Stable client-generated player identifier
+	Campaign      bool   `protobuf:"varint,4,opt,name=campaign,proto3" json:"campaign,omitempty"`                 // Start the player's next campaign day instead of a standalone shift
+	Pipeline      bool   `protobuf:"varint,5,opt,name=pipeline,proto3" json:"pipeline,omitempty"`                 // Return after the first cases; generate the rest in the background ahead of the player
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,6 +241,34 @@ func (x *StartSessionRequest) GetNumCases() int32 {
 	return 0
 }
 
+func (x *StartSessionRequest) GetAdaptive() bool {
+	if x != nil {
+		return x.Adaptive
+	}
+	return false
+}
+
+func (x *StartSessionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetCampaign() bool {
+	if x != nil {
+		return x.Campaign
+	}
+	return false
+}
+
+func (x *StartSessionRequest) GetPipeline() bool {
+	if x != nil {
+		return x.Pipeline
+	}
+	return false
+}
+
 type StartSessionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Update:
@@ -259,7 +355,8 @@ type SessionProgress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Current       int32                  `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // "Generating case 5/20..."
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`          // "Generating case 5/20..."
+	JobId         string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Pass to WatchSessionGeneration to reconnect
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -315,6 +412,13 @@ func (x *SessionProgress) GetMessage() string {
 	return ""
 }
 
+func (x *SessionProgress) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 type SessionReady struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	SessionId            string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -322,6 +426,9 @@ type SessionReady struct {
 	Rules                []string               `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`                       // Daily rules
 	TotalCases           int32                  `protobuf:"varint,4,opt,name=total_cases,json=totalCases,proto3" json:"total_cases,omitempty"`
 	SecondaryChecksQuota int32                  `protobuf:"varint,5,opt,name=secondary_checks_quota,json=secondaryChecksQuota,proto3" json:"secondary_checks_quota,omitempty"` // e.g., 3
+	CampaignDay          int32                  `protobuf:"varint,6,opt,name=campaign_day,json=campaignDay,proto3" json:"campaign_day,omitempty"`                              // 0 for standalone shifts
+	StoryEvent           string                 `protobuf:"bytes,7,opt,name=story_event,json=storyEvent,proto3" json:"story_event,omitempty"`                                  // Today's campaign storyline event
+	ShiftLengthSeconds   int32                  `protobuf:"varint,8,opt,name=shift_length_seconds,json=shiftLengthSeconds,proto3" json:"shift_length_seconds,omitempty"`       // Real time until the shuttle departs, 0 if untimed
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -391,27 +498,48 @@ func (x *SessionReady) GetSecondaryChecksQuota() int32 {
 	return 0
 }
 
-type GetNextCaseRequest struct {
+func (x *SessionReady) GetCampaignDay() int32 {
+	if x != nil {
+		return x.CampaignDay
+	}
+	return 0
+}
+
+func (x *SessionReady) GetStoryEvent() string {
+	if x != nil {
+		return x.StoryEvent
+	}
+	return ""
+}
+
+func (x *SessionReady) GetShiftLengthSeconds() int32 {
+	if x != nil {
+		return x.ShiftLengthSeconds
+	}
+	return 0
+}
+
+type StartSessionGenerationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNextCaseRequest) Reset() {
-	*x = GetNextCaseRequest{}
+func (x *StartSessionGenerationResponse) Reset() {
+	*x = StartSessionGenerationResponse{}
 	mi := &file_game_v1_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNextCaseRequest) String() string {
+func (x *StartSessionGenerationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNextCaseRequest) ProtoMessage() {}
+func (*StartSessionGenerationResponse) ProtoMessage() {}
 
-func (x *GetNextCaseRequest) ProtoReflect() protoreflect.Message {
+func (x *StartSessionGenerationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_game_v1_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,45 +551,39 @@ func (x *GetNextCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNextCaseRequest.ProtoReflect.Descriptor instead.
-func (*GetNextCaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartSessionGenerationResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionGenerationResponse) Descriptor() ([]byte, []int) {
 	return file_game_v1_game_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetNextCaseRequest) GetSessionId() string {
+func (x *StartSessionGenerationResponse) GetJobId() string {
 	if x != nil {
-		return x.SessionId
+		return x.JobId
 	}
 	return ""
 }
 
-type GetNextCaseResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	CaseId                   string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
-	Npc                      *NPCProfile            `protobuf:"bytes,2,opt,name=npc,proto3" json:"npc,omitempty"`
-	Documents                []*Document            `protobuf:"bytes,3,rep,name=documents,proto3" json:"documents,omitempty"`
-	OpeningLine              string                 `protobuf:"bytes,4,opt,name=opening_line,json=openingLine,proto3" json:"opening_line,omitempty"`
-	OpeningAudio             []byte                 `protobuf:"bytes,5,opt,name=opening_audio,json=openingAudio,proto3" json:"opening_audio,omitempty"` // Pre-generated MP3
-	CaseNumber               int32                  `protobuf:"varint,6,opt,name=case_number,json=caseNumber,proto3" json:"case_number,omitempty"`      // e.g., 3 of 15
-	RemainingSecondaryChecks int32                  `protobuf:"varint,7,opt,name=remaining_secondary_checks,json=remainingSecondaryChecks,proto3" json:"remaining_secondary_checks,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type WatchSessionGenerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNextCaseResponse) Reset() {
-	*x = GetNextCaseResponse{}
+func (x *WatchSessionGenerationRequest) Reset() {
+	*x = WatchSessionGenerationRequest{}
 	mi := &file_game_v1_game_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNextCaseResponse) String() string {
+func (x *WatchSessionGenerationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNextCaseResponse) ProtoMessage() {}
+func (*WatchSessionGenerationRequest) ProtoMessage() {}
 
-func (x *GetNextCaseResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchSessionGenerationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_v1_game_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -473,84 +595,84 @@ func (x *GetNextCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNextCaseResponse.ProtoReflect.Descriptor instead.
-func (*GetNextCaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchSessionGenerationRequest.ProtoReflect.Descriptor instead.
+func (*WatchSessionGenerationRequest) Descriptor() ([]byte, []int) {
 	return file_game_v1_game_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetNextCaseResponse) GetCaseId() string {
+func (x *WatchSessionGenerationRequest) GetJobId() string {
 	if x != nil {
-		return x.CaseId
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *GetNextCaseResponse) GetNpc() *NPCProfile {
-	if x != nil {
-		return x.Npc
-	}
-	return nil
+type CancelSessionGenerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNextCaseResponse) GetDocuments() []*Document {
-	if x != nil {
-		return x.Documents
-	}
-	return nil
+func (x *CancelSessionGenerationRequest) Reset() {
+	*x = CancelSessionGenerationRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNextCaseResponse) GetOpeningLine() string {
-	if x != nil {
-		return x.OpeningLine
-	}
-	return ""
+func (x *CancelSessionGenerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetNextCaseResponse) GetOpeningAudio() []byte {
+func (*CancelSessionGenerationRequest) ProtoMessage() {}
+
+func (x *CancelSessionGenerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[6]
 	if x != nil {
-		return x.OpeningAudio
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetNextCaseResponse) GetCaseNumber() int32 {
-	if x != nil {
-		return x.CaseNumber
-	}
-	return 0
+// Deprecated: Use CancelSessionGenerationRequest.ProtoReflect.Descriptor instead.
+func (*CancelSessionGenerationRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetNextCaseResponse) GetRemainingSecondaryChecks() int32 {
+func (x *CancelSessionGenerationRequest) GetJobId() string {
 	if x != nil {
-		return x.RemainingSecondaryChecks
+		return x.JobId
 	}
-	return 0
+	return ""
 }
 
-type AskQuestionRequest struct {
+type CancelSessionGenerationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
-	Question      string                 `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Cancelled     bool                   `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // False if the job had already finished
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AskQuestionRequest) Reset() {
-	*x = AskQuestionRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[6]
+func (x *CancelSessionGenerationResponse) Reset() {
+	*x = CancelSessionGenerationResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AskQuestionRequest) String() string {
+func (x *CancelSessionGenerationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AskQuestionRequest) ProtoMessage() {}
+func (*CancelSessionGenerationResponse) ProtoMessage() {}
 
-func (x *AskQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[6]
+func (x *CancelSessionGenerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,59 +683,41 @@ func (x *AskQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AskQuestionRequest.ProtoReflect.Descriptor instead.
-func (*AskQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *AskQuestionRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *AskQuestionRequest) GetCaseId() string {
-	if x != nil {
-		return x.CaseId
-	}
-	return ""
+// Deprecated: Use CancelSessionGenerationResponse.ProtoReflect.Descriptor instead.
+func (*CancelSessionGenerationResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AskQuestionRequest) GetQuestion() string {
+func (x *CancelSessionGenerationResponse) GetCancelled() bool {
 	if x != nil {
-		return x.Question
+		return x.Cancelled
 	}
-	return ""
+	return false
 }
 
-type AskQuestionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Chunk:
-	//
-	//	*AskQuestionResponse_TextChunk
-	//	*AskQuestionResponse_AudioChunk
-	//	*AskQuestionResponse_Done
-	Chunk         isAskQuestionResponse_Chunk `protobuf_oneof:"chunk"`
+type GetNextCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AudioFormat   AudioFormat            `protobuf:"varint,2,opt,name=audio_format,json=audioFormat,proto3,enum=game.v1.AudioFormat" json:"audio_format,omitempty"` // Preferred codec for opening_audio
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AskQuestionResponse) Reset() {
-	*x = AskQuestionResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[7]
+func (x *GetNextCaseRequest) Reset() {
+	*x = GetNextCaseRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AskQuestionResponse) String() string {
+func (x *GetNextCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AskQuestionResponse) ProtoMessage() {}
+func (*GetNextCaseRequest) ProtoMessage() {}
 
-func (x *AskQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[7]
+func (x *GetNextCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,91 +728,2455 @@ func (x *AskQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AskQuestionResponse.ProtoReflect.Descriptor instead.
-func (*AskQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *AskQuestionResponse) GetChunk() isAskQuestionResponse_Chunk {
-	if x != nil {
-		return x.Chunk
-	}
-	return nil
+// Deprecated: Use GetNextCaseRequest.ProtoReflect.Descriptor instead.
+func (*GetNextCaseRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AskQuestionResponse) GetTextChunk() string {
+func (x *GetNextCaseRequest) GetSessionId() string {
 	if x != nil {
-		if x, ok := x.Chunk.(*AskQuestionResponse_TextChunk); ok {
-			return x.TextChunk
-		}
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *AskQuestionResponse) GetAudioChunk() []byte {
+func (x *GetNextCaseRequest) GetAudioFormat() AudioFormat {
 	if x != nil {
-		if x, ok := x.Chunk.(*AskQuestionResponse_AudioChunk); ok {
-			return x.AudioChunk
-		}
+		return x.AudioFormat
 	}
-	return nil
+	return AudioFormat_AUDIO_FORMAT_UNSPECIFIED
 }
 
-func (x *AskQuestionResponse) GetDone() bool {
-	if x != nil {
-		if x, ok := x.Chunk.(*AskQuestionResponse_Done); ok {
-			return x.Done
-		}
-	}
-	return false
+type GetNextCaseResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	CaseId                   string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Npc                      *NPCProfile            `protobuf:"bytes,2,opt,name=npc,proto3" json:"npc,omitempty"`
+	Documents                []*Document            `protobuf:"bytes,3,rep,name=documents,proto3" json:"documents,omitempty"`
+	OpeningLine              string                 `protobuf:"bytes,4,opt,name=opening_line,json=openingLine,proto3" json:"opening_line,omitempty"`
+	OpeningAudio             []byte                 `protobuf:"bytes,5,opt,name=opening_audio,json=openingAudio,proto3" json:"opening_audio,omitempty"` // Pre-generated audio
+	CaseNumber               int32                  `protobuf:"varint,6,opt,name=case_number,json=caseNumber,proto3" json:"case_number,omitempty"`      // e.g., 3 of 15
+	RemainingSecondaryChecks int32                  `protobuf:"varint,7,opt,name=remaining_secondary_checks,json=remainingSecondaryChecks,proto3" json:"remaining_secondary_checks,omitempty"`
+	RemainingShiftSeconds    int32                  `protobuf:"varint,8,opt,name=remaining_shift_seconds,json=remainingShiftSeconds,proto3" json:"remaining_shift_seconds,omitempty"` // Server-side shift clock
+	OpeningSubtitles         *SubtitleTrack         `protobuf:"bytes,9,opt,name=opening_subtitles,json=openingSubtitles,proto3" json:"opening_subtitles,omitempty"`                   // Word timings for opening_audio
+	OpeningAudioMimeType     string                 `protobuf:"bytes,10,opt,name=opening_audio_mime_type,json=openingAudioMimeType,proto3" json:"opening_audio_mime_type,omitempty"`  // e.g. "audio/mpeg"
+	Degraded                 bool                   `protobuf:"varint,11,opt,name=degraded,proto3" json:"degraded,omitempty"`                                                         // The case is placeholder content because generation failed
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-type isAskQuestionResponse_Chunk interface {
+func (x *GetNextCaseResponse) Reset() {
+	*x = GetNextCaseResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextCaseResponse) ProtoMessage() {}
+
+func (x *GetNextCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextCaseResponse.ProtoReflect.Descriptor instead.
+func (*GetNextCaseResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNextCaseResponse) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *GetNextCaseResponse) GetNpc() *NPCProfile {
+	if x != nil {
+		return x.Npc
+	}
+	return nil
+}
+
+func (x *GetNextCaseResponse) GetDocuments() []*Document {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *GetNextCaseResponse) GetOpeningLine() string {
+	if x != nil {
+		return x.OpeningLine
+	}
+	return ""
+}
+
+func (x *GetNextCaseResponse) GetOpeningAudio() []byte {
+	if x != nil {
+		return x.OpeningAudio
+	}
+	return nil
+}
+
+func (x *GetNextCaseResponse) GetCaseNumber() int32 {
+	if x != nil {
+		return x.CaseNumber
+	}
+	return 0
+}
+
+func (x *GetNextCaseResponse) GetRemainingSecondaryChecks() int32 {
+	if x != nil {
+		return x.RemainingSecondaryChecks
+	}
+	return 0
+}
+
+func (x *GetNextCaseResponse) GetRemainingShiftSeconds() int32 {
+	if x != nil {
+		return x.RemainingShiftSeconds
+	}
+	return 0
+}
+
+func (x *GetNextCaseResponse) GetOpeningSubtitles() *SubtitleTrack {
+	if x != nil {
+		return x.OpeningSubtitles
+	}
+	return nil
+}
+
+func (x *GetNextCaseResponse) GetOpeningAudioMimeType() string {
+	if x != nil {
+		return x.OpeningAudioMimeType
+	}
+	return ""
+}
+
+func (x *GetNextCaseResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+// Word timings for karaoke-style subtitles
+type SubtitleTrack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []*SubtitleWord        `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtitleTrack) Reset() {
+	*x = SubtitleTrack{}
+	mi := &file_game_v1_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtitleTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtitleTrack) ProtoMessage() {}
+
+func (x *SubtitleTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtitleTrack.ProtoReflect.Descriptor instead.
+func (*SubtitleTrack) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubtitleTrack) GetWords() []*SubtitleWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type SubtitleWord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Character offset of the word in the spoken line
+	StartMs       int32                  `protobuf:"varint,3,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	EndMs         int32                  `protobuf:"varint,4,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtitleWord) Reset() {
+	*x = SubtitleWord{}
+	mi := &file_game_v1_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtitleWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtitleWord) ProtoMessage() {}
+
+func (x *SubtitleWord) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtitleWord.ProtoReflect.Descriptor instead.
+func (*SubtitleWord) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubtitleWord) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SubtitleWord) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SubtitleWord) GetStartMs() int32 {
+	if x != nil {
+		return x.StartMs
+	}
+	return 0
+}
+
+func (x *SubtitleWord) GetEndMs() int32 {
+	if x != nil {
+		return x.EndMs
+	}
+	return 0
+}
+
+type AskQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Question      string                 `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	AudioFormat   AudioFormat            `protobuf:"varint,4,opt,name=audio_format,json=audioFormat,proto3,enum=game.v1.AudioFormat" json:"audio_format,omitempty"` // Preferred codec for the answer audio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskQuestionRequest) Reset() {
+	*x = AskQuestionRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskQuestionRequest) ProtoMessage() {}
+
+func (x *AskQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AskQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AskQuestionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AskQuestionRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *AskQuestionRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *AskQuestionRequest) GetAudioFormat() AudioFormat {
+	if x != nil {
+		return x.AudioFormat
+	}
+	return AudioFormat_AUDIO_FORMAT_UNSPECIFIED
+}
+
+type AskQuestionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Chunk:
+	//
+	//	*AskQuestionResponse_TextChunk
+	//	*AskQuestionResponse_AudioChunk
+	//	*AskQuestionResponse_Done
+	//	*AskQuestionResponse_Transcript
+	//	*AskQuestionResponse_Subtitles
+	Chunk         isAskQuestionResponse_Chunk `protobuf_oneof:"chunk"`
+	AudioMimeType string                      `protobuf:"bytes,6,opt,name=audio_mime_type,json=audioMimeType,proto3" json:"audio_mime_type,omitempty"` // Set with audio_chunk, e.g. "audio/mpeg"
+	// Set with text_chunk when the answer is a fallback line rather than the
+	// model's, and with done when the text or the audio fell back
+	Degraded      bool `protobuf:"varint,7,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskQuestionResponse) Reset() {
+	*x = AskQuestionResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskQuestionResponse) ProtoMessage() {}
+
+func (x *AskQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskQuestionResponse.ProtoReflect.Descriptor instead.
+func (*AskQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AskQuestionResponse) GetChunk() isAskQuestionResponse_Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse) GetTextChunk() string {
+	if x != nil {
+		if x, ok := x.Chunk.(*AskQuestionResponse_TextChunk); ok {
+			return x.TextChunk
+		}
+	}
+	return ""
+}
+
+func (x *AskQuestionResponse) GetAudioChunk() []byte {
+	if x != nil {
+		if x, ok := x.Chunk.(*AskQuestionResponse_AudioChunk); ok {
+			return x.AudioChunk
+		}
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse) GetDone() bool {
+	if x != nil {
+		if x, ok := x.Chunk.(*AskQuestionResponse_Done); ok {
+			return x.Done
+		}
+	}
+	return false
+}
+
+func (x *AskQuestionResponse) GetTranscript() string {
+	if x != nil {
+		if x, ok := x.Chunk.(*AskQuestionResponse_Transcript); ok {
+			return x.Transcript
+		}
+	}
+	return ""
+}
+
+func (x *AskQuestionResponse) GetSubtitles() *SubtitleTrack {
+	if x != nil {
+		if x, ok := x.Chunk.(*AskQuestionResponse_Subtitles); ok {
+			return x.Subtitles
+		}
+	}
+	return nil
+}
+
+func (x *AskQuestionResponse) GetAudioMimeType() string {
+	if x != nil {
+		return x.AudioMimeType
+	}
+	return ""
+}
+
+func (x *AskQuestionResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type isAskQuestionResponse_Chunk interface {
 	isAskQuestionResponse_Chunk()
 }
 
-type AskQuestionResponse_TextChunk struct {
-	TextChunk string `protobuf:"bytes,1,opt,name=text_chunk,json=textChunk,proto3,oneof"` // NPC response text (for subtitles)
+type AskQuestionResponse_TextChunk struct {
+	TextChunk string `protobuf:"bytes,1,opt,name=text_chunk,json=textChunk,proto3,oneof"` // NPC response text (for subtitles)
+}
+
+type AskQuestionResponse_AudioChunk struct {
+	AudioChunk []byte `protobuf:"bytes,2,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Audio stream (when ElevenLabs integrated)
+}
+
+type AskQuestionResponse_Done struct {
+	Done bool `protobuf:"varint,3,opt,name=done,proto3,oneof"`
+}
+
+type AskQuestionResponse_Transcript struct {
+	Transcript string `protobuf:"bytes,4,opt,name=transcript,proto3,oneof"` // Recognized text of a voice question, sent before the answer
+}
+
+type AskQuestionResponse_Subtitles struct {
+	Subtitles *SubtitleTrack `protobuf:"bytes,5,opt,name=subtitles,proto3,oneof"` // Word timings for the audio chunk, sent right after it
+}
+
+func (*AskQuestionResponse_TextChunk) isAskQuestionResponse_Chunk() {}
+
+func (*AskQuestionResponse_AudioChunk) isAskQuestionResponse_Chunk() {}
+
+func (*AskQuestionResponse_Done) isAskQuestionResponse_Chunk() {}
+
+func (*AskQuestionResponse_Transcript) isAskQuestionResponse_Chunk() {}
+
+func (*AskQuestionResponse_Subtitles) isAskQuestionResponse_Chunk() {}
+
+// The first message must be start; audio chunks follow until the client
+// closes its side of the stream
+type VoiceQuestionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Input:
+	//
+	//	*VoiceQuestionRequest_Start
+	//	*VoiceQuestionRequest_AudioChunk
+	Input         isVoiceQuestionRequest_Input `protobuf_oneof:"input"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoiceQuestionRequest) Reset() {
+	*x = VoiceQuestionRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoiceQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoiceQuestionRequest) ProtoMessage() {}
+
+func (x *VoiceQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoiceQuestionRequest.ProtoReflect.Descriptor instead.
+func (*VoiceQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VoiceQuestionRequest) GetInput() isVoiceQuestionRequest_Input {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *VoiceQuestionRequest) GetStart() *VoiceQuestionStart {
+	if x != nil {
+		if x, ok := x.Input.(*VoiceQuestionRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *VoiceQuestionRequest) GetAudioChunk() []byte {
+	if x != nil {
+		if x, ok := x.Input.(*VoiceQuestionRequest_AudioChunk); ok {
+			return x.AudioChunk
+		}
+	}
+	return nil
+}
+
+type isVoiceQuestionRequest_Input interface {
+	isVoiceQuestionRequest_Input()
+}
+
+type VoiceQuestionRequest_Start struct {
+	Start *VoiceQuestionStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type VoiceQuestionRequest_AudioChunk struct {
+	AudioChunk []byte `protobuf:"bytes,2,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Microphone audio
+}
+
+func (*VoiceQuestionRequest_Start) isVoiceQuestionRequest_Input() {}
+
+func (*VoiceQuestionRequest_AudioChunk) isVoiceQuestionRequest_Input() {}
+
+type VoiceQuestionStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`                                    // e.g. "audio/webm" or "audio/wav"
+	AudioFormat   AudioFormat            `protobuf:"varint,4,opt,name=audio_format,json=audioFormat,proto3,enum=game.v1.AudioFormat" json:"audio_format,omitempty"` // Preferred codec for the answer audio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoiceQuestionStart) Reset() {
+	*x = VoiceQuestionStart{}
+	mi := &file_game_v1_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoiceQuestionStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoiceQuestionStart) ProtoMessage() {}
+
+func (x *VoiceQuestionStart) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoiceQuestionStart.ProtoReflect.Descriptor instead.
+func (*VoiceQuestionStart) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VoiceQuestionStart) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *VoiceQuestionStart) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *VoiceQuestionStart) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *VoiceQuestionStart) GetAudioFormat() AudioFormat {
+	if x != nil {
+		return x.AudioFormat
+	}
+	return AudioFormat_AUDIO_FORMAT_UNSPECIFIED
+}
+
+type InterrogateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Input:
+	//
+	//	*InterrogateRequest_Start
+	//	*InterrogateRequest_Question
+	//	*InterrogateRequest_AudioChunk
+	//	*InterrogateRequest_AudioEnd
+	//	*InterrogateRequest_Interrupt
+	Input         isInterrogateRequest_Input `protobuf_oneof:"input"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterrogateRequest) Reset() {
+	*x = InterrogateRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterrogateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterrogateRequest) ProtoMessage() {}
+
+func (x *InterrogateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterrogateRequest.ProtoReflect.Descriptor instead.
+func (*InterrogateRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InterrogateRequest) GetInput() isInterrogateRequest_Input {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *InterrogateRequest) GetStart() *InterrogateStart {
+	if x != nil {
+		if x, ok := x.Input.(*InterrogateRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *InterrogateRequest) GetQuestion() string {
+	if x != nil {
+		if x, ok := x.Input.(*InterrogateRequest_Question); ok {
+			return x.Question
+		}
+	}
+	return ""
+}
+
+func (x *InterrogateRequest) GetAudioChunk() []byte {
+	if x != nil {
+		if x, ok := x.Input.(*InterrogateRequest_AudioChunk); ok {
+			return x.AudioChunk
+		}
+	}
+	return nil
+}
+
+func (x *InterrogateRequest) GetAudioEnd() bool {
+	if x != nil {
+		if x, ok := x.Input.(*InterrogateRequest_AudioEnd); ok {
+			return x.AudioEnd
+		}
+	}
+	return false
+}
+
+func (x *InterrogateRequest) GetInterrupt() bool {
+	if x != nil {
+		if x, ok := x.Input.(*InterrogateRequest_Interrupt); ok {
+			return x.Interrupt
+		}
+	}
+	return false
+}
+
+type isInterrogateRequest_Input interface {
+	isInterrogateRequest_Input()
+}
+
+type InterrogateRequest_Start struct {
+	Start *InterrogateStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"` // Must be the first message
+}
+
+type InterrogateRequest_Question struct {
+	Question string `protobuf:"bytes,2,opt,name=question,proto3,oneof"` // Typed question
+}
+
+type InterrogateRequest_AudioChunk struct {
+	AudioChunk []byte `protobuf:"bytes,3,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Part of a spoken question
+}
+
+type InterrogateRequest_AudioEnd struct {
+	AudioEnd bool `protobuf:"varint,4,opt,name=audio_end,json=audioEnd,proto3,oneof"` // The spoken question is complete
+}
+
+type InterrogateRequest_Interrupt struct {
+	Interrupt bool `protobuf:"varint,5,opt,name=interrupt,proto3,oneof"` // Stop the answer in progress
+}
+
+func (*InterrogateRequest_Start) isInterrogateRequest_Input() {}
+
+func (*InterrogateRequest_Question) isInterrogateRequest_Input() {}
+
+func (*InterrogateRequest_AudioChunk) isInterrogateRequest_Input() {}
+
+func (*InterrogateRequest_AudioEnd) isInterrogateRequest_Input() {}
+
+func (*InterrogateRequest_Interrupt) isInterrogateRequest_Input() {}
+
+type InterrogateStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`                                    // Audio format of spoken questions, e.g. "audio/webm"
+	AudioFormat   AudioFormat            `protobuf:"varint,4,opt,name=audio_format,json=audioFormat,proto3,enum=game.v1.AudioFormat" json:"audio_format,omitempty"` // Preferred codec for the answer audio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterrogateStart) Reset() {
+	*x = InterrogateStart{}
+	mi := &file_game_v1_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterrogateStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterrogateStart) ProtoMessage() {}
+
+func (x *InterrogateStart) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterrogateStart.ProtoReflect.Descriptor instead.
+func (*InterrogateStart) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InterrogateStart) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *InterrogateStart) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *InterrogateStart) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *InterrogateStart) GetAudioFormat() AudioFormat {
+	if x != nil {
+		return x.AudioFormat
+	}
+	return AudioFormat_AUDIO_FORMAT_UNSPECIFIED
+}
+
+type InterrogateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Turn  int32                  `protobuf:"varint,1,opt,name=turn,proto3" json:"turn,omitempty"` // Question this chunk belongs to, counting from 1
+	// Types that are valid to be assigned to Chunk:
+	//
+	//	*InterrogateResponse_Transcript
+	//	*InterrogateResponse_TextChunk
+	//	*InterrogateResponse_AudioChunk
+	//	*InterrogateResponse_Done
+	//	*InterrogateResponse_Interrupted
+	//	*InterrogateResponse_Error
+	Chunk         isInterrogateResponse_Chunk `protobuf_oneof:"chunk"`
+	AudioMimeType string                      `protobuf:"bytes,8,opt,name=audio_mime_type,json=audioMimeType,proto3" json:"audio_mime_type,omitempty"` // Set with audio_chunk, e.g. "audio/mpeg"
+	// Set with text_chunk when the answer is a fallback line rather than the
+	// model's, and with done when the text or the audio fell back
+	Degraded      bool `protobuf:"varint,9,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterrogateResponse) Reset() {
+	*x = InterrogateResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterrogateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterrogateResponse) ProtoMessage() {}
+
+func (x *InterrogateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterrogateResponse.ProtoReflect.Descriptor instead.
+func (*InterrogateResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InterrogateResponse) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *InterrogateResponse) GetChunk() isInterrogateResponse_Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *InterrogateResponse) GetTranscript() string {
+	if x != nil {
+		if x, ok := x.Chunk.(*InterrogateResponse_Transcript); ok {
+			return x.Transcript
+		}
+	}
+	return ""
+}
+
+func (x *InterrogateResponse) GetTextChunk() string {
+	if x != nil {
+		if x, ok := x.Chunk.(*InterrogateResponse_TextChunk); ok {
+			return x.TextChunk
+		}
+	}
+	return ""
+}
+
+func (x *InterrogateResponse) GetAudioChunk() []byte {
+	if x != nil {
+		if x, ok := x.Chunk.(*InterrogateResponse_AudioChunk); ok {
+			return x.AudioChunk
+		}
+	}
+	return nil
+}
+
+func (x *InterrogateResponse) GetDone() bool {
+	if x != nil {
+		if x, ok := x.Chunk.(*InterrogateResponse_Done); ok {
+			return x.Done
+		}
+	}
+	return false
+}
+
+func (x *InterrogateResponse) GetInterrupted() bool {
+	if x != nil {
+		if x, ok := x.Chunk.(*InterrogateResponse_Interrupted); ok {
+			return x.Interrupted
+		}
+	}
+	return false
+}
+
+func (x *InterrogateResponse) GetError() string {
+	if x != nil {
+		if x, ok := x.Chunk.(*InterrogateResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return ""
+}
+
+func (x *InterrogateResponse) GetAudioMimeType() string {
+	if x != nil {
+		return x.AudioMimeType
+	}
+	return ""
+}
+
+func (x *InterrogateResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type isInterrogateResponse_Chunk interface {
+	isInterrogateResponse_Chunk()
+}
+
+type InterrogateResponse_Transcript struct {
+	Transcript string `protobuf:"bytes,2,opt,name=transcript,proto3,oneof"` // Recognized text of a spoken question
+}
+
+type InterrogateResponse_TextChunk struct {
+	TextChunk string `protobuf:"bytes,3,opt,name=text_chunk,json=textChunk,proto3,oneof"` // NPC answer text
+}
+
+type InterrogateResponse_AudioChunk struct {
+	AudioChunk []byte `protobuf:"bytes,4,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Part of the NPC answer audio; concatenate the chunks of a turn
+}
+
+type InterrogateResponse_Done struct {
+	Done bool `protobuf:"varint,5,opt,name=done,proto3,oneof"` // The answer is complete
+}
+
+type InterrogateResponse_Interrupted struct {
+	Interrupted bool `protobuf:"varint,6,opt,name=interrupted,proto3,oneof"` // The answer was cut off by a new question or an interrupt
+}
+
+type InterrogateResponse_Error struct {
+	Error string `protobuf:"bytes,7,opt,name=error,proto3,oneof"` // The question couldn't be answered; the conversation continues
+}
+
+func (*InterrogateResponse_Transcript) isInterrogateResponse_Chunk() {}
+
+func (*InterrogateResponse_TextChunk) isInterrogateResponse_Chunk() {}
+
+func (*InterrogateResponse_AudioChunk) isInterrogateResponse_Chunk() {}
+
+func (*InterrogateResponse_Done) isInterrogateResponse_Chunk() {}
+
+func (*InterrogateResponse_Interrupted) isInterrogateResponse_Chunk() {}
+
+func (*InterrogateResponse_Error) isInterrogateResponse_Chunk() {}
+
+type SecondaryCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	EmployeeId    string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"` // From document
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecondaryCheckRequest) Reset() {
+	*x = SecondaryCheckRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecondaryCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecondaryCheckRequest) ProtoMessage() {}
+
+func (x *SecondaryCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecondaryCheckRequest.ProtoReflect.Descriptor instead.
+func (*SecondaryCheckRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SecondaryCheckRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SecondaryCheckRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *SecondaryCheckRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+type SecondaryCheckResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Valid           bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // e.g., "Contract verified: Term ends 2024-12-30"
+	RemainingChecks int32                  `protobuf:"varint,3,opt,name=remaining_checks,json=remainingChecks,proto3" json:"remaining_checks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SecondaryCheckResponse) Reset() {
+	*x = SecondaryCheckResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecondaryCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecondaryCheckResponse) ProtoMessage() {}
+
+func (x *SecondaryCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecondaryCheckResponse.ProtoReflect.Descriptor instead.
+func (*SecondaryCheckResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SecondaryCheckResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *SecondaryCheckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SecondaryCheckResponse) GetRemainingChecks() int32 {
+	if x != nil {
+		return x.RemainingChecks
+	}
+	return 0
+}
+
+type ResolveCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Decision      Decision               `protobuf:"varint,3,opt,name=decision,proto3,enum=game.v1.Decision" json:"decision,omitempty"`
+	FlaggedFields []*FlaggedField        `protobuf:"bytes,4,rep,name=flagged_fields,json=flaggedFields,proto3" json:"flagged_fields,omitempty"`                     // Fields the player marked as violating the rules
+	AudioFormat   AudioFormat            `protobuf:"varint,5,opt,name=audio_format,json=audioFormat,proto3,enum=game.v1.AudioFormat" json:"audio_format,omitempty"` // Preferred codec for npc_reaction_audio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCaseRequest) Reset() {
+	*x = ResolveCaseRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCaseRequest) ProtoMessage() {}
+
+func (x *ResolveCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCaseRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCaseRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResolveCaseRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResolveCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *ResolveCaseRequest) GetDecision() Decision {
+	if x != nil {
+		return x.Decision
+	}
+	return Decision_DECISION_UNSPECIFIED
+}
+
+func (x *ResolveCaseRequest) GetFlaggedFields() []*FlaggedField {
+	if x != nil {
+		return x.FlaggedFields
+	}
+	return nil
+}
+
+func (x *ResolveCaseRequest) GetAudioFormat() AudioFormat {
+	if x != nil {
+		return x.AudioFormat
+	}
+	return AudioFormat_AUDIO_FORMAT_UNSPECIFIED
+}
+
+type FlaggedField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentType  string                 `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"` // "employee_badge" or "clearance_form"
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`                                   // e.g. "cargo1", "expire_date"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlaggedField) Reset() {
+	*x = FlaggedField{}
+	mi := &file_game_v1_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlaggedField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlaggedField) ProtoMessage() {}
+
+func (x *FlaggedField) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlaggedField.ProtoReflect.Descriptor instead.
+func (*FlaggedField) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FlaggedField) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *FlaggedField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type ResolveCaseResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Correct                  bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	Verdict                  string                 `protobuf:"bytes,2,opt,name=verdict,proto3" json:"verdict,omitempty"`                                                    // Explanation of what was right/wrong
+	ContradictionsFound      []string               `protobuf:"bytes,3,rep,name=contradictions_found,json=contradictionsFound,proto3" json:"contradictions_found,omitempty"` // What player should have caught
+	ScoreDelta               int32                  `protobuf:"varint,4,opt,name=score_delta,json=scoreDelta,proto3" json:"score_delta,omitempty"`                           // Points earned/lost
+	TotalScore               int32                  `protobuf:"varint,5,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	Outcome                  CaseOutcome            `protobuf:"varint,6,opt,name=outcome,proto3,enum=game.v1.CaseOutcome" json:"outcome,omitempty"`
+	NpcReactionText          string                 `protobuf:"bytes,7,opt,name=npc_reaction_text,json=npcReactionText,proto3" json:"npc_reaction_text,omitempty"`                                 // Thank you message or insult
+	NpcReactionAudio         []byte                 `protobuf:"bytes,8,opt,name=npc_reaction_audio,json=npcReactionAudio,proto3" json:"npc_reaction_audio,omitempty"`                              // Pre-generated audio response
+	TimeBonus                int32                  `protobuf:"varint,9,opt,name=time_bonus,json=timeBonus,proto3" json:"time_bonus,omitempty"`                                                    // Included in score_delta: bonus for quick, penalty for slow decisions
+	DecisionSeconds          int32                  `protobuf:"varint,10,opt,name=decision_seconds,json=decisionSeconds,proto3" json:"decision_seconds,omitempty"`                                 // Time from serving the case to the decision
+	ScoreBreakdown           []*ScoreItem           `protobuf:"bytes,11,rep,name=score_breakdown,json=scoreBreakdown,proto3" json:"score_breakdown,omitempty"`                                     // Itemized score_delta
+	Streak                   int32                  `protobuf:"varint,12,opt,name=streak,proto3" json:"streak,omitempty"`                                                                          // Consecutive correct decisions including this one
+	Citations                int32                  `protobuf:"varint,13,opt,name=citations,proto3" json:"citations,omitempty"`                                                                    // Citations issued this shift
+	ReactionSubtitles        *SubtitleTrack         `protobuf:"bytes,14,opt,name=reaction_subtitles,json=reactionSubtitles,proto3" json:"reaction_subtitles,omitempty"`                            // Word timings for npc_reaction_audio
+	NpcReactionAudioMimeType string                 `protobuf:"bytes,15,opt,name=npc_reaction_audio_mime_type,json=npcReactionAudioMimeType,proto3" json:"npc_reaction_audio_mime_type,omitempty"` // e.g. "audio/mpeg"
+	Degraded                 bool                   `protobuf:"varint,16,opt,name=degraded,proto3" json:"degraded,omitempty"`                                                                      // The verdict or reaction is a fallback line, or the reaction audio failed
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ResolveCaseResponse) Reset() {
+	*x = ResolveCaseResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCaseResponse) ProtoMessage() {}
+
+func (x *ResolveCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCaseResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCaseResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResolveCaseResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *ResolveCaseResponse) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *ResolveCaseResponse) GetContradictionsFound() []string {
+	if x != nil {
+		return x.ContradictionsFound
+	}
+	return nil
+}
+
+func (x *ResolveCaseResponse) GetScoreDelta() int32 {
+	if x != nil {
+		return x.ScoreDelta
+	}
+	return 0
+}
+
+func (x *ResolveCaseResponse) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *ResolveCaseResponse) GetOutcome() CaseOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return CaseOutcome_CASE_OUTCOME_UNSPECIFIED
+}
+
+func (x *ResolveCaseResponse) GetNpcReactionText() string {
+	if x != nil {
+		return x.NpcReactionText
+	}
+	return ""
+}
+
+func (x *ResolveCaseResponse) GetNpcReactionAudio() []byte {
+	if x != nil {
+		return x.NpcReactionAudio
+	}
+	return nil
+}
+
+func (x *ResolveCaseResponse) GetTimeBonus() int32 {
+	if x != nil {
+		return x.TimeBonus
+	}
+	return 0
+}
+
+func (x *ResolveCaseResponse) GetDecisionSeconds() int32 {
+	if x != nil {
+		return x.DecisionSeconds
+	}
+	return 0
+}
+
+func (x *ResolveCaseResponse) GetScoreBreakdown() []*ScoreItem {
+	if x != nil {
+		return x.ScoreBreakdown
+	}
+	return nil
+}
+
+func (x *ResolveCaseResponse) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *ResolveCaseResponse) GetCitations() int32 {
+	if x != nil {
+		return x.Citations
+	}
+	return 0
+}
+
+func (x *ResolveCaseResponse) GetReactionSubtitles() *SubtitleTrack {
+	if x != nil {
+		return x.ReactionSubtitles
+	}
+	return nil
+}
+
+func (x *ResolveCaseResponse) GetNpcReactionAudioMimeType() string {
+	if x != nil {
+		return x.NpcReactionAudioMimeType
+	}
+	return ""
+}
+
+func (x *ResolveCaseResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type ScoreItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`   // "outcome", "streak", "speed", "citation", "secondary_checks", "flags"
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"` // Player-facing explanation
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreItem) Reset() {
+	*x = ScoreItem{}
+	mi := &file_game_v1_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreItem) ProtoMessage() {}
+
+func (x *ScoreItem) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreItem.ProtoReflect.Descriptor instead.
+func (*ScoreItem) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScoreItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ScoreItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ScoreItem) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type GetSessionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionStatusRequest) Reset() {
+	*x = GetSessionStatusRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatusRequest) ProtoMessage() {}
+
+func (x *GetSessionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSessionStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetSessionStatusResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	CasesCompleted           int32                  `protobuf:"varint,1,opt,name=cases_completed,json=casesCompleted,proto3" json:"cases_completed,omitempty"`
+	TotalCases               int32                  `protobuf:"varint,2,opt,name=total_cases,json=totalCases,proto3" json:"total_cases,omitempty"`
+	TotalScore               int32                  `protobuf:"varint,3,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	CorrectDecisions         int32                  `protobuf:"varint,4,opt,name=correct_decisions,json=correctDecisions,proto3" json:"correct_decisions,omitempty"`
+	IncorrectDecisions       int32                  `protobuf:"varint,5,opt,name=incorrect_decisions,json=incorrectDecisions,proto3" json:"incorrect_decisions,omitempty"`
+	RemainingSecondaryChecks int32                  `protobuf:"varint,6,opt,name=remaining_secondary_checks,json=remainingSecondaryChecks,proto3" json:"remaining_secondary_checks,omitempty"`
+	SessionComplete          bool                   `protobuf:"varint,7,opt,name=session_complete,json=sessionComplete,proto3" json:"session_complete,omitempty"`
+	RemainingShiftSeconds    int32                  `protobuf:"varint,8,opt,name=remaining_shift_seconds,json=remainingShiftSeconds,proto3" json:"remaining_shift_seconds,omitempty"` // 0 once the shuttle has departed
+	ShiftClock               string                 `protobuf:"bytes,9,opt,name=shift_clock,json=shiftClock,proto3" json:"shift_clock,omitempty"`                                     // In-game time, e.g. "14:35"
+	ShuttleDeparted          bool                   `protobuf:"varint,10,opt,name=shuttle_departed,json=shuttleDeparted,proto3" json:"shuttle_departed,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetSessionStatusResponse) Reset() {
+	*x = GetSessionStatusResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatusResponse) ProtoMessage() {}
+
+func (x *GetSessionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSessionStatusResponse) GetCasesCompleted() int32 {
+	if x != nil {
+		return x.CasesCompleted
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetTotalCases() int32 {
+	if x != nil {
+		return x.TotalCases
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetCorrectDecisions() int32 {
+	if x != nil {
+		return x.CorrectDecisions
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetIncorrectDecisions() int32 {
+	if x != nil {
+		return x.IncorrectDecisions
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetRemainingSecondaryChecks() int32 {
+	if x != nil {
+		return x.RemainingSecondaryChecks
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetSessionComplete() bool {
+	if x != nil {
+		return x.SessionComplete
+	}
+	return false
+}
+
+func (x *GetSessionStatusResponse) GetRemainingShiftSeconds() int32 {
+	if x != nil {
+		return x.RemainingShiftSeconds
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetShiftClock() string {
+	if x != nil {
+		return x.ShiftClock
+	}
+	return ""
+}
+
+func (x *GetSessionStatusResponse) GetShuttleDeparted() bool {
+	if x != nil {
+		return x.ShuttleDeparted
+	}
+	return false
+}
+
+type GetCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignRequest) Reset() {
+	*x = GetCampaignRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignRequest) ProtoMessage() {}
+
+func (x *GetCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCampaignRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"` // Last started campaign day, 0 if none
+	GameDate      string                 `protobuf:"bytes,2,opt,name=game_date,json=gameDate,proto3" json:"game_date,omitempty"`
+	Rules         []string               `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"` // Rules in force on the current day
+	Days          []*CampaignDay         `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignResponse) Reset() {
+	*x = GetCampaignResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignResponse) ProtoMessage() {}
+
+func (x *GetCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignResponse.ProtoReflect.Descriptor instead.
+func (*GetCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCampaignResponse) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *GetCampaignResponse) GetGameDate() string {
+	if x != nil {
+		return x.GameDate
+	}
+	return ""
+}
+
+func (x *GetCampaignResponse) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetCampaignResponse) GetDays() []*CampaignDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type CampaignDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	GameDate      string                 `protobuf:"bytes,2,opt,name=game_date,json=gameDate,proto3" json:"game_date,omitempty"`
+	Rules         []string               `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	StoryEvent    string                 `protobuf:"bytes,4,opt,name=story_event,json=storyEvent,proto3" json:"story_event,omitempty"`
+	SessionId     string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignDay) Reset() {
+	*x = CampaignDay{}
+	mi := &file_game_v1_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignDay) ProtoMessage() {}
+
+func (x *CampaignDay) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignDay.ProtoReflect.Descriptor instead.
+func (*CampaignDay) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CampaignDay) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *CampaignDay) GetGameDate() string {
+	if x != nil {
+		return x.GameDate
+	}
+	return ""
+}
+
+func (x *CampaignDay) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CampaignDay) GetStoryEvent() string {
+	if x != nil {
+		return x.StoryEvent
+	}
+	return ""
+}
+
+func (x *CampaignDay) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetShiftReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionId      string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	IncludeSummary bool                   `protobuf:"varint,2,opt,name=include_summary,json=includeSummary,proto3" json:"include_summary,omitempty"` // Ask the supervisor to write a summary of weak spots
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetShiftReportRequest) Reset() {
+	*x = GetShiftReportRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftReportRequest) ProtoMessage() {}
+
+func (x *GetShiftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftReportRequest.ProtoReflect.Descriptor instead.
+func (*GetShiftReportRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetShiftReportRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetShiftReportRequest) GetIncludeSummary() bool {
+	if x != nil {
+		return x.IncludeSummary
+	}
+	return false
+}
+
+type GetShiftReportResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	SessionId          string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameDate           string                 `protobuf:"bytes,2,opt,name=game_date,json=gameDate,proto3" json:"game_date,omitempty"`
+	TotalScore         int32                  `protobuf:"varint,3,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	CorrectDecisions   int32                  `protobuf:"varint,4,opt,name=correct_decisions,json=correctDecisions,proto3" json:"correct_decisions,omitempty"`
+	IncorrectDecisions int32                  `protobuf:"varint,5,opt,name=incorrect_decisions,json=incorrectDecisions,proto3" json:"incorrect_decisions,omitempty"`
+	Citations          int32                  `protobuf:"varint,6,opt,name=citations,proto3" json:"citations,omitempty"`
+	TotalCases         int32                  `protobuf:"varint,7,opt,name=total_cases,json=totalCases,proto3" json:"total_cases,omitempty"`
+	Cases              []*CaseDebrief         `protobuf:"bytes,8,rep,name=cases,proto3" json:"cases,omitempty"`                                                  // Cases that were served, in order
+	SupervisorSummary  string                 `protobuf:"bytes,9,opt,name=supervisor_summary,json=supervisorSummary,proto3" json:"supervisor_summary,omitempty"` // Empty unless include_summary was set
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetShiftReportResponse) Reset() {
+	*x = GetShiftReportResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftReportResponse) ProtoMessage() {}
+
+func (x *GetShiftReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftReportResponse.ProtoReflect.Descriptor instead.
+func (*GetShiftReportResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetShiftReportResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetShiftReportResponse) GetGameDate() string {
+	if x != nil {
+		return x.GameDate
+	}
+	return ""
+}
+
+func (x *GetShiftReportResponse) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *GetShiftReportResponse) GetCorrectDecisions() int32 {
+	if x != nil {
+		return x.CorrectDecisions
+	}
+	return 0
+}
+
+func (x *GetShiftReportResponse) GetIncorrectDecisions() int32 {
+	if x != nil {
+		return x.IncorrectDecisions
+	}
+	return 0
+}
+
+func (x *GetShiftReportResponse) GetCitations() int32 {
+	if x != nil {
+		return x.Citations
+	}
+	return 0
+}
+
+func (x *GetShiftReportResponse) GetTotalCases() int32 {
+	if x != nil {
+		return x.TotalCases
+	}
+	return 0
+}
+
+func (x *GetShiftReportResponse) GetCases() []*CaseDebrief {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+func (x *GetShiftReportResponse) GetSupervisorSummary() string {
+	if x != nil {
+		return x.SupervisorSummary
+	}
+	return ""
+}
+
+type CaseDebrief struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CaseId              string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	NpcName             string                 `protobuf:"bytes,2,opt,name=npc_name,json=npcName,proto3" json:"npc_name,omitempty"`
+	PlayerDecision      Decision               `protobuf:"varint,3,opt,name=player_decision,json=playerDecision,proto3,enum=game.v1.Decision" json:"player_decision,omitempty"` // UNSPECIFIED if the case was never decided
+	CorrectDecision     Decision               `protobuf:"varint,4,opt,name=correct_decision,json=correctDecision,proto3,enum=game.v1.Decision" json:"correct_decision,omitempty"`
+	Correct             bool                   `protobuf:"varint,5,opt,name=correct,proto3" json:"correct,omitempty"`
+	CaughtViolations    []*Violation           `protobuf:"bytes,6,rep,name=caught_violations,json=caughtViolations,proto3" json:"caught_violations,omitempty"`
+	MissedViolations    []*Violation           `protobuf:"bytes,7,rep,name=missed_violations,json=missedViolations,proto3" json:"missed_violations,omitempty"`
+	QuestionsAsked      []string               `protobuf:"bytes,8,rep,name=questions_asked,json=questionsAsked,proto3" json:"questions_asked,omitempty"`
+	SecondaryChecksUsed int32                  `protobuf:"varint,9,opt,name=secondary_checks_used,json=secondaryChecksUsed,proto3" json:"secondary_checks_used,omitempty"`
+	DecisionSeconds     int32                  `protobuf:"varint,10,opt,name=decision_seconds,json=decisionSeconds,proto3" json:"decision_seconds,omitempty"` // Time from serving the case to the decision
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CaseDebrief) Reset() {
+	*x = CaseDebrief{}
+	mi := &file_game_v1_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseDebrief) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseDebrief) ProtoMessage() {}
+
+func (x *CaseDebrief) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseDebrief.ProtoReflect.Descriptor instead.
+func (*CaseDebrief) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CaseDebrief) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *CaseDebrief) GetNpcName() string {
+	if x != nil {
+		return x.NpcName
+	}
+	return ""
+}
+
+func (x *CaseDebrief) GetPlayerDecision() Decision {
+	if x != nil {
+		return x.PlayerDecision
+	}
+	return Decision_DECISION_UNSPECIFIED
+}
+
+func (x *CaseDebrief) GetCorrectDecision() Decision {
+	if x != nil {
+		return x.CorrectDecision
+	}
+	return Decision_DECISION_UNSPECIFIED
+}
+
+func (x *CaseDebrief) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *CaseDebrief) GetCaughtViolations() []*Violation {
+	if x != nil {
+		return x.CaughtViolations
+	}
+	return nil
+}
+
+func (x *CaseDebrief) GetMissedViolations() []*Violation {
+	if x != nil {
+		return x.MissedViolations
+	}
+	return nil
+}
+
+func (x *CaseDebrief) GetQuestionsAsked() []string {
+	if x != nil {
+		return x.QuestionsAsked
+	}
+	return nil
+}
+
+func (x *CaseDebrief) GetSecondaryChecksUsed() int32 {
+	if x != nil {
+		return x.SecondaryChecksUsed
+	}
+	return 0
+}
+
+func (x *CaseDebrief) GetDecisionSeconds() int32 {
+	if x != nil {
+		return x.DecisionSeconds
+	}
+	return 0
+}
+
+type Violation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentType  string                 `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"` // "employee_badge" or "clearance_form"
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"` // The rule that was broken
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Violation) Reset() {
+	*x = Violation{}
+	mi := &file_game_v1_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Violation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Violation) ProtoMessage() {}
+
+func (x *Violation) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Violation.ProtoReflect.Descriptor instead.
+func (*Violation) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Violation) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *Violation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Violation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type ResumeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                                    // Must match the player_id the session was started with
+	AudioFormat   AudioFormat            `protobuf:"varint,3,opt,name=audio_format,json=audioFormat,proto3,enum=game.v1.AudioFormat" json:"audio_format,omitempty"` // Preferred codec for the current case's opening audio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSessionRequest) Reset() {
+	*x = ResumeSessionRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSessionRequest) ProtoMessage() {}
+
+func (x *ResumeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResumeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResumeSessionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ResumeSessionRequest) GetAudioFormat() AudioFormat {
+	if x != nil {
+		return x.AudioFormat
+	}
+	return AudioFormat_AUDIO_FORMAT_UNSPECIFIED
+}
+
+type ResumeSessionResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	SessionId                string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameDate                 string                 `protobuf:"bytes,2,opt,name=game_date,json=gameDate,proto3" json:"game_date,omitempty"`
+	Rules                    []string               `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	CurrentCase              *GetNextCaseResponse   `protobuf:"bytes,4,opt,name=current_case,json=currentCase,proto3" json:"current_case,omitempty"` // Unset when no case is waiting
+	Transcript               []*DialogueLine        `protobuf:"bytes,5,rep,name=transcript,proto3" json:"transcript,omitempty"`                      // Questions and answers on the current case so far
+	TotalScore               int32                  `protobuf:"varint,6,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	CorrectDecisions         int32                  `protobuf:"varint,7,opt,name=correct_decisions,json=correctDecisions,proto3" json:"correct_decisions,omitempty"`
+	IncorrectDecisions       int32                  `protobuf:"varint,8,opt,name=incorrect_decisions,json=incorrectDecisions,proto3" json:"incorrect_decisions,omitempty"`
+	RemainingSecondaryChecks int32                  `protobuf:"varint,9,opt,name=remaining_secondary_checks,json=remainingSecondaryChecks,proto3" json:"remaining_secondary_checks,omitempty"`
+	CasesCompleted           int32                  `protobuf:"varint,10,opt,name=cases_completed,json=casesCompleted,proto3" json:"cases_completed,omitempty"`
+	TotalCases               int32                  `protobuf:"varint,11,opt,name=total_cases,json=totalCases,proto3" json:"total_cases,omitempty"`
+	SessionComplete          bool                   `protobuf:"varint,12,opt,name=session_complete,json=sessionComplete,proto3" json:"session_complete,omitempty"`
+	CampaignDay              int32                  `protobuf:"varint,13,opt,name=campaign_day,json=campaignDay,proto3" json:"campaign_day,omitempty"` // 0 for standalone shifts
+	StoryEvent               string                 `protobuf:"bytes,14,opt,name=story_event,json=storyEvent,proto3" json:"story_event,omitempty"`     // Today's campaign story event
+	RemainingShiftSeconds    int32                  `protobuf:"varint,15,opt,name=remaining_shift_seconds,json=remainingShiftSeconds,proto3" json:"remaining_shift_seconds,omitempty"`
+	ShiftClock               string                 `protobuf:"bytes,16,opt,name=shift_clock,json=shiftClock,proto3" json:"shift_clock,omitempty"` // In-game time, e.g. "14:35"
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ResumeSessionResponse) Reset() {
+	*x = ResumeSessionResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSessionResponse) ProtoMessage() {}
+
+func (x *ResumeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSessionResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResumeSessionResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResumeSessionResponse) GetGameDate() string {
+	if x != nil {
+		return x.GameDate
+	}
+	return ""
+}
+
+func (x *ResumeSessionResponse) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ResumeSessionResponse) GetCurrentCase() *GetNextCaseResponse {
+	if x != nil {
+		return x.CurrentCase
+	}
+	return nil
+}
+
+func (x *ResumeSessionResponse) GetTranscript() []*DialogueLine {
+	if x != nil {
+		return x.Transcript
+	}
+	return nil
+}
+
+func (x *ResumeSessionResponse) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetCorrectDecisions() int32 {
+	if x != nil {
+		return x.CorrectDecisions
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetIncorrectDecisions() int32 {
+	if x != nil {
+		return x.IncorrectDecisions
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetRemainingSecondaryChecks() int32 {
+	if x != nil {
+		return x.RemainingSecondaryChecks
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetCasesCompleted() int32 {
+	if x != nil {
+		return x.CasesCompleted
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetTotalCases() int32 {
+	if x != nil {
+		return x.TotalCases
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetSessionComplete() bool {
+	if x != nil {
+		return x.SessionComplete
+	}
+	return false
+}
+
+func (x *ResumeSessionResponse) GetCampaignDay() int32 {
+	if x != nil {
+		return x.CampaignDay
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetStoryEvent() string {
+	if x != nil {
+		return x.StoryEvent
+	}
+	return ""
+}
+
+func (x *ResumeSessionResponse) GetRemainingShiftSeconds() int32 {
+	if x != nil {
+		return x.RemainingShiftSeconds
+	}
+	return 0
+}
+
+func (x *ResumeSessionResponse) GetShiftClock() string {
+	if x != nil {
+		return x.ShiftClock
+	}
+	return ""
+}
+
+type DialogueLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DialogueLine) Reset() {
+	*x = DialogueLine{}
+	mi := &file_game_v1_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type AskQuestionResponse_AudioChunk struct {
-	AudioChunk []byte `protobuf:"bytes,2,opt,name=audio_chunk,json=audioChunk,proto3,oneof"` // Audio stream (when ElevenLabs integrated)
+func (x *DialogueLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type AskQuestionResponse_Done struct {
-	Done bool `protobuf:"varint,3,opt,name=done,proto3,oneof"`
+func (*DialogueLine) ProtoMessage() {}
+
+func (x *DialogueLine) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*AskQuestionResponse_TextChunk) isAskQuestionResponse_Chunk() {}
+// Deprecated: Use DialogueLine.ProtoReflect.Descriptor instead.
+func (*DialogueLine) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{36}
+}
 
-func (*AskQuestionResponse_AudioChunk) isAskQuestionResponse_Chunk() {}
+func (x *DialogueLine) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
 
-func (*AskQuestionResponse_Done) isAskQuestionResponse_Chunk() {}
+func (x *DialogueLine) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
 
-type SecondaryCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
-	EmployeeId    string                 `protobuf:"bytes,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"` // From document
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListMySessionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId       string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	UnfinishedOnly bool                   `protobuf:"varint,2,opt,name=unfinished_only,json=unfinishedOnly,proto3" json:"unfinished_only,omitempty"` // Only sessions that can still be resumed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SecondaryCheckRequest) Reset() {
-	*x = SecondaryCheckRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[8]
+func (x *ListMySessionsRequest) Reset() {
+	*x = ListMySessionsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecondaryCheckRequest) String() string {
+func (x *ListMySessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecondaryCheckRequest) ProtoMessage() {}
+func (*ListMySessionsRequest) ProtoMessage() {}
 
-func (x *SecondaryCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[8]
+func (x *ListMySessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,56 +3187,98 @@ func (x *SecondaryCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecondaryCheckRequest.ProtoReflect.Descriptor instead.
-func (*SecondaryCheckRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListMySessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMySessionsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SecondaryCheckRequest) GetSessionId() string {
+func (x *ListMySessionsRequest) GetPlayerId() string {
 	if x != nil {
-		return x.SessionId
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *SecondaryCheckRequest) GetCaseId() string {
+func (x *ListMySessionsRequest) GetUnfinishedOnly() bool {
 	if x != nil {
-		return x.CaseId
+		return x.UnfinishedOnly
 	}
-	return ""
+	return false
 }
 
-func (x *SecondaryCheckRequest) GetEmployeeId() string {
+type ListMySessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionSummary      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsResponse) Reset() {
+	*x = ListMySessionsResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsResponse) ProtoMessage() {}
+
+func (x *ListMySessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[38]
 	if x != nil {
-		return x.EmployeeId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type SecondaryCheckResponse struct {
+// Deprecated: Use ListMySessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMySessionsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMySessionsResponse) GetSessions() []*SessionSummary {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SessionSummary struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Valid           bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // e.g., "Contract verified: Term ends 2024-12-30"
-	RemainingChecks int32                  `protobuf:"varint,3,opt,name=remaining_checks,json=remainingChecks,proto3" json:"remaining_checks,omitempty"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameDate        string                 `protobuf:"bytes,2,opt,name=game_date,json=gameDate,proto3" json:"game_date,omitempty"`
+	CampaignDay     int32                  `protobuf:"varint,3,opt,name=campaign_day,json=campaignDay,proto3" json:"campaign_day,omitempty"`
+	CasesCompleted  int32                  `protobuf:"varint,4,opt,name=cases_completed,json=casesCompleted,proto3" json:"cases_completed,omitempty"`
+	TotalCases      int32                  `protobuf:"varint,5,opt,name=total_cases,json=totalCases,proto3" json:"total_cases,omitempty"`
+	TotalScore      int32                  `protobuf:"varint,6,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	SessionComplete bool                   `protobuf:"varint,7,opt,name=session_complete,json=sessionComplete,proto3" json:"session_complete,omitempty"`
+	StartedAt       int64                  `protobuf:"varint,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // Unix seconds
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SecondaryCheckResponse) Reset() {
-	*x = SecondaryCheckResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[9]
+func (x *SessionSummary) Reset() {
+	*x = SessionSummary{}
+	mi := &file_game_v1_game_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecondaryCheckResponse) String() string {
+func (x *SessionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecondaryCheckResponse) ProtoMessage() {}
+func (*SessionSummary) ProtoMessage() {}
 
-func (x *SecondaryCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[9]
+func (x *SessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,56 +3289,96 @@ func (x *SecondaryCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecondaryCheckResponse.ProtoReflect.Descriptor instead.
-func (*SecondaryCheckResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SessionSummary.ProtoReflect.Descriptor instead.
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SecondaryCheckResponse) GetValid() bool {
+func (x *SessionSummary) GetSessionId() string {
 	if x != nil {
-		return x.Valid
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *SecondaryCheckResponse) GetMessage() string {
+func (x *SessionSummary) GetGameDate() string {
 	if x != nil {
-		return x.Message
+		return x.GameDate
 	}
 	return ""
 }
 
-func (x *SecondaryCheckResponse) GetRemainingChecks() int32 {
+func (x *SessionSummary) GetCampaignDay() int32 {
 	if x != nil {
-		return x.RemainingChecks
+		return x.CampaignDay
 	}
 	return 0
 }
 
-type ResolveCaseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	CaseId        string                 `protobuf:"bytes,2,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
-	Decision      Decision               `protobuf:"varint,3,opt,name=decision,proto3,enum=game.v1.Decision" json:"decision,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SessionSummary) GetCasesCompleted() int32 {
+	if x != nil {
+		return x.CasesCompleted
+	}
+	return 0
 }
 
-func (x *ResolveCaseRequest) Reset() {
-	*x = ResolveCaseRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[10]
+func (x *SessionSummary) GetTotalCases() int32 {
+	if x != nil {
+		return x.TotalCases
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetTotalScore() int32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetSessionComplete() bool {
+	if x != nil {
+		return x.SessionComplete
+	}
+	return false
+}
+
+func (x *SessionSummary) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+type NPCProfile struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role               string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // e.g., "Drill Operator", "Maintenance Tech"
+	Department         string                 `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"`
+	Personality        string                 `protobuf:"bytes,4,opt,name=personality,proto3" json:"personality,omitempty"`                                          // e.g., "tired", "angry", "nervous"
+	PortraitUrl        string                 `protobuf:"bytes,5,opt,name=portrait_url,json=portraitUrl,proto3" json:"portrait_url,omitempty"`                       // Optional: URL to generated portrait
+	Demeanor           string                 `protobuf:"bytes,6,opt,name=demeanor,proto3" json:"demeanor,omitempty"`                                                // e.g., "evasive", "cooperative", "frustrated"
+	NpcId              string                 `protobuf:"bytes,7,opt,name=npc_id,json=npcId,proto3" json:"npc_id,omitempty"`                                         // Stable roster ID for recurring workers
+	PreviousEncounters int32                  `protobuf:"varint,8,opt,name=previous_encounters,json=previousEncounters,proto3" json:"previous_encounters,omitempty"` // Times this worker has met the player before
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NPCProfile) Reset() {
+	*x = NPCProfile{}
+	mi := &file_game_v1_game_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveCaseRequest) String() string {
+func (x *NPCProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveCaseRequest) ProtoMessage() {}
+func (*NPCProfile) ProtoMessage() {}
 
-func (x *ResolveCaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[10]
+func (x *NPCProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,61 +3389,91 @@ func (x *ResolveCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveCaseRequest.ProtoReflect.Descriptor instead.
-func (*ResolveCaseRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use NPCProfile.ProtoReflect.Descriptor instead.
+func (*NPCProfile) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ResolveCaseRequest) GetSessionId() string {
+func (x *NPCProfile) GetName() string {
 	if x != nil {
-		return x.SessionId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ResolveCaseRequest) GetCaseId() string {
+func (x *NPCProfile) GetRole() string {
 	if x != nil {
-		return x.CaseId
+		return x.Role
 	}
 	return ""
 }
 
-func (x *ResolveCaseRequest) GetDecision() Decision {
+func (x *NPCProfile) GetDepartment() string {
 	if x != nil {
-		return x.Decision
+		return x.Department
 	}
-	return Decision_DECISION_UNSPECIFIED
+	return ""
 }
 
-type ResolveCaseResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Correct             bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
-	Verdict             string                 `protobuf:"bytes,2,opt,name=verdict,proto3" json:"verdict,omitempty"`                                                    // Explanation of what was right/wrong
-	ContradictionsFound []string               `protobuf:"bytes,3,rep,name=contradictions_found,json=contradictionsFound,proto3" json:"contradictions_found,omitempty"` // What player should have caught
-	ScoreDelta          int32                  `protobuf:"varint,4,opt,name=score_delta,json=scoreDelta,proto3" json:"score_delta,omitempty"`                           // Points earned/lost
-	TotalScore          int32                  `protobuf:"varint,5,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
-	Outcome             CaseOutcome            `protobuf:"varint,6,opt,name=outcome,proto3,enum=game.v1.CaseOutcome" json:"outcome,omitempty"`
-	NpcReactionText     string                 `protobuf:"bytes,7,opt,name=npc_reaction_text,json=npcReactionText,proto3" json:"npc_reaction_text,omitempty"`    // Thank you message or insult
-	NpcReactionAudio    []byte                 `protobuf:"bytes,8,opt,name=npc_reaction_audio,json=npcReactionAudio,proto3" json:"npc_reaction_audio,omitempty"` // Pre-generated audio response
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *NPCProfile) GetPersonality() string {
+	if x != nil {
+		return x.Personality
+	}
+	return ""
 }
 
-func (x *ResolveCaseResponse) Reset() {
-	*x = ResolveCaseResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[11]
+func (x *NPCProfile) GetPortraitUrl() string {
+	if x != nil {
+		return x.PortraitUrl
+	}
+	return ""
+}
+
+func (x *NPCProfile) GetDemeanor() string {
+	if x != nil {
+		return x.Demeanor
+	}
+	return ""
+}
+
+func (x *NPCProfile) GetNpcId() string {
+	if x != nil {
+		return x.NpcId
+	}
+	return ""
+}
+
+func (x *NPCProfile) GetPreviousEncounters() int32 {
+	if x != nil {
+		return x.PreviousEncounters
+	}
+	return 0
+}
+
+type Document struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                                                               // "contract", "shift_log", "clearance_badge"
+	Fields        map[string]string      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Key-value pairs of document data
+	VisualUrl     string                 `protobuf:"bytes,3,opt,name=visual_url,json=visualUrl,proto3" json:"visual_url,omitempty"`                                                    // Optional: rendered document image
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_game_v1_game_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveCaseResponse) String() string {
+func (x *Document) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveCaseResponse) ProtoMessage() {}
+func (*Document) ProtoMessage() {}
 
-func (x *ResolveCaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[11]
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,89 +3484,98 @@ func (x *ResolveCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveCaseResponse.ProtoReflect.Descriptor instead.
-func (*ResolveCaseResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ResolveCaseResponse) GetCorrect() bool {
+func (x *Document) GetType() string {
 	if x != nil {
-		return x.Correct
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *ResolveCaseResponse) GetVerdict() string {
+func (x *Document) GetFields() map[string]string {
 	if x != nil {
-		return x.Verdict
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolveCaseResponse) GetContradictionsFound() []string {
+func (x *Document) GetVisualUrl() string {
 	if x != nil {
-		return x.ContradictionsFound
+		return x.VisualUrl
 	}
-	return nil
+	return ""
+}
+
+type ListVoicesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDisabled bool                   `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ResolveCaseResponse) GetScoreDelta() int32 {
-	if x != nil {
-		return x.ScoreDelta
-	}
-	return 0
+func (x *ListVoicesRequest) Reset() {
+	*x = ListVoicesRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveCaseResponse) GetTotalScore() int32 {
-	if x != nil {
-		return x.TotalScore
-	}
-	return 0
+func (x *ListVoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ResolveCaseResponse) GetOutcome() CaseOutcome {
+func (*ListVoicesRequest) ProtoMessage() {}
+
+func (x *ListVoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[42]
 	if x != nil {
-		return x.Outcome
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return CaseOutcome_CASE_OUTCOME_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *ResolveCaseResponse) GetNpcReactionText() string {
-	if x != nil {
-		return x.NpcReactionText
-	}
-	return ""
+// Deprecated: Use ListVoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListVoicesRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ResolveCaseResponse) GetNpcReactionAudio() []byte {
+func (x *ListVoicesRequest) GetIncludeDisabled() bool {
 	if x != nil {
-		return x.NpcReactionAudio
+		return x.IncludeDisabled
 	}
-	return nil
+	return false
 }
 
-type GetSessionStatusRequest struct {
+type ListVoicesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Voices        []*VoiceInfo           `protobuf:"bytes,1,rep,name=voices,proto3" json:"voices,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSessionStatusRequest) Reset() {
-	*x = GetSessionStatusRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[12]
+func (x *ListVoicesResponse) Reset() {
+	*x = ListVoicesResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionStatusRequest) String() string {
+func (x *ListVoicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionStatusRequest) ProtoMessage() {}
+func (*ListVoicesResponse) ProtoMessage() {}
 
-func (x *GetSessionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[12]
+func (x *ListVoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,46 +3586,50 @@ func (x *GetSessionStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListVoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListVoicesResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetSessionStatusRequest) GetSessionId() string {
+func (x *ListVoicesResponse) GetVoices() []*VoiceInfo {
 	if x != nil {
-		return x.SessionId
+		return x.Voices
 	}
-	return ""
-}
-
-type GetSessionStatusResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	CasesCompleted           int32                  `protobuf:"varint,1,opt,name=cases_completed,json=casesCompleted,proto3" json:"cases_completed,omitempty"`
-	TotalCases               int32                  `protobuf:"varint,2,opt,name=total_cases,json=totalCases,proto3" json:"total_cases,omitempty"`
-	TotalScore               int32                  `protobuf:"varint,3,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
-	CorrectDecisions         int32                  `protobuf:"varint,4,opt,name=correct_decisions,json=correctDecisions,proto3" json:"correct_decisions,omitempty"`
-	IncorrectDecisions       int32                  `protobuf:"varint,5,opt,name=incorrect_decisions,json=incorrectDecisions,proto3" json:"incorrect_decisions,omitempty"`
-	RemainingSecondaryChecks int32                  `protobuf:"varint,6,opt,name=remaining_secondary_checks,json=remainingSecondaryChecks,proto3" json:"remaining_secondary_checks,omitempty"`
-	SessionComplete          bool                   `protobuf:"varint,7,opt,name=session_complete,json=sessionComplete,proto3" json:"session_complete,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	return nil
 }
 
-func (x *GetSessionStatusResponse) Reset() {
-	*x = GetSessionStatusResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[13]
+type VoiceInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled          bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	VocalAge         string                 `protobuf:"bytes,5,opt,name=vocal_age,json=vocalAge,proto3" json:"vocal_age,omitempty"`
+	Pitch            string                 `protobuf:"bytes,6,opt,name=pitch,proto3" json:"pitch,omitempty"`
+	Timbres          []string               `protobuf:"bytes,7,rep,name=timbres,proto3" json:"timbres,omitempty"`
+	Accent           string                 `protobuf:"bytes,8,opt,name=accent,proto3" json:"accent,omitempty"`
+	Energy           string                 `protobuf:"bytes,9,opt,name=energy,proto3" json:"energy,omitempty"`
+	Languages        []string               `protobuf:"bytes,10,rep,name=languages,proto3" json:"languages,omitempty"`
+	EmotionOverrides []string               `protobuf:"bytes,11,rep,name=emotion_overrides,json=emotionOverrides,proto3" json:"emotion_overrides,omitempty"` // Emotions with voice setting overrides
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VoiceInfo) Reset() {
+	*x = VoiceInfo{}
+	mi := &file_game_v1_game_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSessionStatusResponse) String() string {
+func (x *VoiceInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionStatusResponse) ProtoMessage() {}
+func (*VoiceInfo) ProtoMessage() {}
 
-func (x *GetSessionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[13]
+func (x *VoiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,87 +3640,111 @@ func (x *GetSessionStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use VoiceInfo.ProtoReflect.Descriptor instead.
+func (*VoiceInfo) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetSessionStatusResponse) GetCasesCompleted() int32 {
+func (x *VoiceInfo) GetId() string {
 	if x != nil {
-		return x.CasesCompleted
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSessionStatusResponse) GetTotalCases() int32 {
+func (x *VoiceInfo) GetName() string {
 	if x != nil {
-		return x.TotalCases
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSessionStatusResponse) GetTotalScore() int32 {
+func (x *VoiceInfo) GetDescription() string {
 	if x != nil {
-		return x.TotalScore
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSessionStatusResponse) GetCorrectDecisions() int32 {
+func (x *VoiceInfo) GetEnabled() bool {
 	if x != nil {
-		return x.CorrectDecisions
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *GetSessionStatusResponse) GetIncorrectDecisions() int32 {
+func (x *VoiceInfo) GetVocalAge() string {
 	if x != nil {
-		return x.IncorrectDecisions
+		return x.VocalAge
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSessionStatusResponse) GetRemainingSecondaryChecks() int32 {
+func (x *VoiceInfo) GetPitch() string {
 	if x != nil {
-		return x.RemainingSecondaryChecks
+		return x.Pitch
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSessionStatusResponse) GetSessionComplete() bool {
+func (x *VoiceInfo) GetTimbres() []string {
 	if x != nil {
-		return x.SessionComplete
+		return x.Timbres
 	}
-	return false
+	return nil
 }
 
-type NPCProfile struct {
+func (x *VoiceInfo) GetAccent() string {
+	if x != nil {
+		return x.Accent
+	}
+	return ""
+}
+
+func (x *VoiceInfo) GetEnergy() string {
+	if x != nil {
+		return x.Energy
+	}
+	return ""
+}
+
+func (x *VoiceInfo) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *VoiceInfo) GetEmotionOverrides() []string {
+	if x != nil {
+		return x.EmotionOverrides
+	}
+	return nil
+}
+
+type GetUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // e.g., "Drill Operator", "Maintenance Tech"
-	Department    string                 `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"`
-	Personality   string                 `protobuf:"bytes,4,opt,name=personality,proto3" json:"personality,omitempty"`                    // e.g., "tired", "angry", "nervous"
-	PortraitUrl   string                 `protobuf:"bytes,5,opt,name=portrait_url,json=portraitUrl,proto3" json:"portrait_url,omitempty"` // Optional: URL to generated portrait
-	Demeanor      string                 `protobuf:"bytes,6,opt,name=demeanor,proto3" json:"demeanor,omitempty"`                          // e.g., "evasive", "cooperative", "frustrated"
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // Optional
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`    // Optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NPCProfile) Reset() {
-	*x = NPCProfile{}
-	mi := &file_game_v1_game_proto_msgTypes[14]
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NPCProfile) String() string {
+func (x *GetUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NPCProfile) ProtoMessage() {}
+func (*GetUsageRequest) ProtoMessage() {}
 
-func (x *NPCProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[14]
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,77 +3755,112 @@ func (x *NPCProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NPCProfile.ProtoReflect.Descriptor instead.
-func (*NPCProfile) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *NPCProfile) GetName() string {
+func (x *GetUsageRequest) GetSessionId() string {
 	if x != nil {
-		return x.Name
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *NPCProfile) GetRole() string {
+func (x *GetUsageRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Role
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *NPCProfile) GetDepartment() string {
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *UsageTotals           `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`   // Since the server started
+	Session       *UsageTotals           `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"` // Unset unless session_id was given
+	Player        *UsageTotals           `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`   // Unset unless player_id was given
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[46]
 	if x != nil {
-		return x.Department
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NPCProfile) GetPersonality() string {
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUsageResponse) GetServer() *UsageTotals {
 	if x != nil {
-		return x.Personality
+		return x.Server
 	}
-	return ""
+	return nil
 }
 
-func (x *NPCProfile) GetPortraitUrl() string {
+func (x *GetUsageResponse) GetSession() *UsageTotals {
 	if x != nil {
-		return x.PortraitUrl
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-func (x *NPCProfile) GetDemeanor() string {
+func (x *GetUsageResponse) GetPlayer() *UsageTotals {
 	if x != nil {
-		return x.Demeanor
+		return x.Player
 	}
-	return ""
+	return nil
 }
 
-type Document struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                                                               // "contract", "shift_log", "clearance_badge"
-	Fields        map[string]string      `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Key-value pairs of document data
-	VisualUrl     string                 `protobuf:"bytes,3,opt,name=visual_url,json=visualUrl,proto3" json:"visual_url,omitempty"`                                                    // Optional: rendered document image
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UsageTotals struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LlmCalls         int64                  `protobuf:"varint,1,opt,name=llm_calls,json=llmCalls,proto3" json:"llm_calls,omitempty"`
+	PromptTokens     int64                  `protobuf:"varint,2,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	OutputTokens     int64                  `protobuf:"varint,3,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"` // Includes thinking tokens
+	TtsCalls         int64                  `protobuf:"varint,4,opt,name=tts_calls,json=ttsCalls,proto3" json:"tts_calls,omitempty"`
+	TtsCharacters    int64                  `protobuf:"varint,5,opt,name=tts_characters,json=ttsCharacters,proto3" json:"tts_characters,omitempty"`
+	EstimatedCostUsd float64                `protobuf:"fixed64,6,opt,name=estimated_cost_usd,json=estimatedCostUsd,proto3" json:"estimated_cost_usd,omitempty"` // From the configured prices
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Document) Reset() {
-	*x = Document{}
-	mi := &file_game_v1_game_proto_msgTypes[15]
+func (x *UsageTotals) Reset() {
+	*x = UsageTotals{}
+	mi := &file_game_v1_game_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Document) String() string {
+func (x *UsageTotals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Document) ProtoMessage() {}
+func (*UsageTotals) ProtoMessage() {}
 
-func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[15]
+func (x *UsageTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,47 +3871,73 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Document.ProtoReflect.Descriptor instead.
-func (*Document) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UsageTotals.ProtoReflect.Descriptor instead.
+func (*UsageTotals) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Document) GetType() string {
+func (x *UsageTotals) GetLlmCalls() int64 {
 	if x != nil {
-		return x.Type
+		return x.LlmCalls
 	}
-	return ""
+	return 0
 }
 
-func (x *Document) GetFields() map[string]string {
+func (x *UsageTotals) GetPromptTokens() int64 {
 	if x != nil {
-		return x.Fields
+		return x.PromptTokens
 	}
-	return nil
+	return 0
 }
 
-func (x *Document) GetVisualUrl() string {
+func (x *UsageTotals) GetOutputTokens() int64 {
 	if x != nil {
-		return x.VisualUrl
+		return x.OutputTokens
 	}
-	return ""
+	return 0
+}
+
+func (x *UsageTotals) GetTtsCalls() int64 {
+	if x != nil {
+		return x.TtsCalls
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetTtsCharacters() int64 {
+	if x != nil {
+		return x.TtsCharacters
+	}
+	return 0
+}
+
+func (x *UsageTotals) GetEstimatedCostUsd() float64 {
+	if x != nil {
+		return x.EstimatedCostUsd
+	}
+	return 0
 }
 
 var File_game_v1_game_proto protoreflect.FileDescriptor
 
 const file_game_v1_game_proto_rawDesc = "" +
 	"\n" +
-	"\x12game/v1/game.proto\x12\agame.v1\"2\n" +
+	"\x12game/v1/game.proto\x12\agame.v1\"\xa3\x01\n" +
 	"\x13StartSessionRequest\x12\x1b\n" +
-	"\tnum_cases\x18\x01 \x01(\x05R\bnumCases\"\x87\x01\n" +
+	"\tnum_cases\x18\x01 \x01(\x05R\bnumCases\x12\x1a\n" +
+	"\badaptive\x18\x02 \x01(\bR\badaptive\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x1a\n" +
+	"\bcampaign\x18\x04 \x01(\bR\bcampaign\x12\x1a\n" +
+	"\bpipeline\x18\x05 \x01(\bR\bpipeline\"\x87\x01\n" +
 	"\x14StartSessionResponse\x126\n" +
 	"\bprogress\x18\x01 \x01(\v2\x18.game.v1.SessionProgressH\x00R\bprogress\x12-\n" +
 	"\x05ready\x18\x02 \x01(\v2\x15.game.v1.SessionReadyH\x00R\x05readyB\b\n" +
-	"\x06update\"[\n" +
+	"\x06update\"r\n" +
 	"\x0fSessionProgress\x12\x18\n" +
 	"\acurrent\x18\x01 \x01(\x05R\acurrent\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xb7\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\"\xad\x02\n" +
 	"\fSessionReady\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
@@ -1267,10 +3945,23 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x05rules\x18\x03 \x03(\tR\x05rules\x12\x1f\n" +
 	"\vtotal_cases\x18\x04 \x01(\x05R\n" +
 	"totalCases\x124\n" +
-	"\x16secondary_checks_quota\x18\x05 \x01(\x05R\x14secondaryChecksQuota\"3\n" +
+	"\x16secondary_checks_quota\x18\x05 \x01(\x05R\x14secondaryChecksQuota\x12!\n" +
+	"\fcampaign_day\x18\x06 \x01(\x05R\vcampaignDay\x12\x1f\n" +
+	"\vstory_event\x18\a \x01(\tR\n" +
+	"storyEvent\x120\n" +
+	"\x14shift_length_seconds\x18\b \x01(\x05R\x12shiftLengthSeconds\"7\n" +
+	"\x1eStartSessionGenerationResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"6\n" +
+	"\x1dWatchSessionGenerationRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"7\n" +
+	"\x1eCancelSessionGenerationRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"?\n" +
+	"\x1fCancelSessionGenerationResponse\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\bR\tcancelled\"l\n" +
 	"\x12GetNextCaseRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\xad\x02\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x127\n" +
+	"\faudio_format\x18\x02 \x01(\x0e2\x14.game.v1.AudioFormatR\vaudioFormat\"\xfd\x03\n" +
 	"\x13GetNextCaseResponse\x12\x17\n" +
 	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12%\n" +
 	"\x03npc\x18\x02 \x01(\v2\x13.game.v1.NPCProfileR\x03npc\x12/\n" +
@@ -1279,18 +3970,77 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\ropening_audio\x18\x05 \x01(\fR\fopeningAudio\x12\x1f\n" +
 	"\vcase_number\x18\x06 \x01(\x05R\n" +
 	"caseNumber\x12<\n" +
-	"\x1aremaining_secondary_checks\x18\a \x01(\x05R\x18remainingSecondaryChecks\"h\n" +
+	"\x1aremaining_secondary_checks\x18\a \x01(\x05R\x18remainingSecondaryChecks\x126\n" +
+	"\x17remaining_shift_seconds\x18\b \x01(\x05R\x15remainingShiftSeconds\x12C\n" +
+	"\x11opening_subtitles\x18\t \x01(\v2\x16.game.v1.SubtitleTrackR\x10openingSubtitles\x125\n" +
+	"\x17opening_audio_mime_type\x18\n" +
+	" \x01(\tR\x14openingAudioMimeType\x12\x1a\n" +
+	"\bdegraded\x18\v \x01(\bR\bdegraded\"<\n" +
+	"\rSubtitleTrack\x12+\n" +
+	"\x05words\x18\x01 \x03(\v2\x15.game.v1.SubtitleWordR\x05words\"l\n" +
+	"\fSubtitleWord\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x19\n" +
+	"\bstart_ms\x18\x03 \x01(\x05R\astartMs\x12\x15\n" +
+	"\x06end_ms\x18\x04 \x01(\x05R\x05endMs\"\xa1\x01\n" +
 	"\x12AskQuestionRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\acase_id\x18\x02 \x01(\tR\x06caseId\x12\x1a\n" +
-	"\bquestion\x18\x03 \x01(\tR\bquestion\"x\n" +
+	"\bquestion\x18\x03 \x01(\tR\bquestion\x127\n" +
+	"\faudio_format\x18\x04 \x01(\x0e2\x14.game.v1.AudioFormatR\vaudioFormat\"\x96\x02\n" +
 	"\x13AskQuestionResponse\x12\x1f\n" +
 	"\n" +
 	"text_chunk\x18\x01 \x01(\tH\x00R\ttextChunk\x12!\n" +
 	"\vaudio_chunk\x18\x02 \x01(\fH\x00R\n" +
 	"audioChunk\x12\x14\n" +
-	"\x04done\x18\x03 \x01(\bH\x00R\x04doneB\a\n" +
+	"\x04done\x18\x03 \x01(\bH\x00R\x04done\x12 \n" +
+	"\n" +
+	"transcript\x18\x04 \x01(\tH\x00R\n" +
+	"transcript\x126\n" +
+	"\tsubtitles\x18\x05 \x01(\v2\x16.game.v1.SubtitleTrackH\x00R\tsubtitles\x12&\n" +
+	"\x0faudio_mime_type\x18\x06 \x01(\tR\raudioMimeType\x12\x1a\n" +
+	"\bdegraded\x18\a \x01(\bR\bdegradedB\a\n" +
+	"\x05chunk\"w\n" +
+	"\x14VoiceQuestionRequest\x123\n" +
+	"\x05start\x18\x01 \x01(\v2\x1b.game.v1.VoiceQuestionStartH\x00R\x05start\x12!\n" +
+	"\vaudio_chunk\x18\x02 \x01(\fH\x00R\n" +
+	"audioChunkB\a\n" +
+	"\x05input\"\xa2\x01\n" +
+	"\x12VoiceQuestionStart\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\acase_id\x18\x02 \x01(\tR\x06caseId\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x127\n" +
+	"\faudio_format\x18\x04 \x01(\x0e2\x14.game.v1.AudioFormatR\vaudioFormat\"\xd0\x01\n" +
+	"\x12InterrogateRequest\x121\n" +
+	"\x05start\x18\x01 \x01(\v2\x19.game.v1.InterrogateStartH\x00R\x05start\x12\x1c\n" +
+	"\bquestion\x18\x02 \x01(\tH\x00R\bquestion\x12!\n" +
+	"\vaudio_chunk\x18\x03 \x01(\fH\x00R\n" +
+	"audioChunk\x12\x1d\n" +
+	"\taudio_end\x18\x04 \x01(\bH\x00R\baudioEnd\x12\x1e\n" +
+	"\tinterrupt\x18\x05 \x01(\bH\x00R\tinterruptB\a\n" +
+	"\x05input\"\xa0\x01\n" +
+	"\x10InterrogateStart\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\acase_id\x18\x02 \x01(\tR\x06caseId\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x127\n" +
+	"\faudio_format\x18\x04 \x01(\x0e2\x14.game.v1.AudioFormatR\vaudioFormat\"\xae\x02\n" +
+	"\x13InterrogateResponse\x12\x12\n" +
+	"\x04turn\x18\x01 \x01(\x05R\x04turn\x12 \n" +
+	"\n" +
+	"transcript\x18\x02 \x01(\tH\x00R\n" +
+	"transcript\x12\x1f\n" +
+	"\n" +
+	"text_chunk\x18\x03 \x01(\tH\x00R\ttextChunk\x12!\n" +
+	"\vaudio_chunk\x18\x04 \x01(\fH\x00R\n" +
+	"audioChunk\x12\x14\n" +
+	"\x04done\x18\x05 \x01(\bH\x00R\x04done\x12\"\n" +
+	"\vinterrupted\x18\x06 \x01(\bH\x00R\vinterrupted\x12\x16\n" +
+	"\x05error\x18\a \x01(\tH\x00R\x05error\x12&\n" +
+	"\x0faudio_mime_type\x18\b \x01(\tR\raudioMimeType\x12\x1a\n" +
+	"\bdegraded\x18\t \x01(\bR\bdegradedB\a\n" +
 	"\x05chunk\"p\n" +
 	"\x15SecondaryCheckRequest\x12\x1d\n" +
 	"\n" +
@@ -1301,12 +4051,17 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x16SecondaryCheckResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
-	"\x10remaining_checks\x18\x03 \x01(\x05R\x0fremainingChecks\"{\n" +
+	"\x10remaining_checks\x18\x03 \x01(\x05R\x0fremainingChecks\"\xf2\x01\n" +
 	"\x12ResolveCaseRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\acase_id\x18\x02 \x01(\tR\x06caseId\x12-\n" +
-	"\bdecision\x18\x03 \x01(\x0e2\x11.game.v1.DecisionR\bdecision\"\xc8\x02\n" +
+	"\bdecision\x18\x03 \x01(\x0e2\x11.game.v1.DecisionR\bdecision\x12<\n" +
+	"\x0eflagged_fields\x18\x04 \x03(\v2\x15.game.v1.FlaggedFieldR\rflaggedFields\x127\n" +
+	"\faudio_format\x18\x05 \x01(\x0e2\x14.game.v1.AudioFormatR\vaudioFormat\"I\n" +
+	"\fFlaggedField\x12#\n" +
+	"\rdocument_type\x18\x01 \x01(\tR\fdocumentType\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\"\xa8\x05\n" +
 	"\x13ResolveCaseResponse\x12\x18\n" +
 	"\acorrect\x18\x01 \x01(\bR\acorrect\x12\x18\n" +
 	"\averdict\x18\x02 \x01(\tR\averdict\x121\n" +
@@ -1317,10 +4072,24 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"totalScore\x12.\n" +
 	"\aoutcome\x18\x06 \x01(\x0e2\x14.game.v1.CaseOutcomeR\aoutcome\x12*\n" +
 	"\x11npc_reaction_text\x18\a \x01(\tR\x0fnpcReactionText\x12,\n" +
-	"\x12npc_reaction_audio\x18\b \x01(\fR\x10npcReactionAudio\"8\n" +
+	"\x12npc_reaction_audio\x18\b \x01(\fR\x10npcReactionAudio\x12\x1d\n" +
+	"\n" +
+	"time_bonus\x18\t \x01(\x05R\ttimeBonus\x12)\n" +
+	"\x10decision_seconds\x18\n" +
+	" \x01(\x05R\x0fdecisionSeconds\x12;\n" +
+	"\x0fscore_breakdown\x18\v \x03(\v2\x12.game.v1.ScoreItemR\x0escoreBreakdown\x12\x16\n" +
+	"\x06streak\x18\f \x01(\x05R\x06streak\x12\x1c\n" +
+	"\tcitations\x18\r \x01(\x05R\tcitations\x12E\n" +
+	"\x12reaction_subtitles\x18\x0e \x01(\v2\x16.game.v1.SubtitleTrackR\x11reactionSubtitles\x12>\n" +
+	"\x1cnpc_reaction_audio_mime_type\x18\x0f \x01(\tR\x18npcReactionAudioMimeType\x12\x1a\n" +
+	"\bdegraded\x18\x10 \x01(\bR\bdegraded\"M\n" +
+	"\tScoreItem\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\"8\n" +
 	"\x17GetSessionStatusRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\xcc\x02\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xd0\x03\n" +
 	"\x18GetSessionStatusResponse\x12'\n" +
 	"\x0fcases_completed\x18\x01 \x01(\x05R\x0ecasesCompleted\x12\x1f\n" +
 	"\vtotal_cases\x18\x02 \x01(\x05R\n" +
@@ -1330,7 +4099,111 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x11correct_decisions\x18\x04 \x01(\x05R\x10correctDecisions\x12/\n" +
 	"\x13incorrect_decisions\x18\x05 \x01(\x05R\x12incorrectDecisions\x12<\n" +
 	"\x1aremaining_secondary_checks\x18\x06 \x01(\x05R\x18remainingSecondaryChecks\x12)\n" +
-	"\x10session_complete\x18\a \x01(\bR\x0fsessionComplete\"\xb5\x01\n" +
+	"\x10session_complete\x18\a \x01(\bR\x0fsessionComplete\x126\n" +
+	"\x17remaining_shift_seconds\x18\b \x01(\x05R\x15remainingShiftSeconds\x12\x1f\n" +
+	"\vshift_clock\x18\t \x01(\tR\n" +
+	"shiftClock\x12)\n" +
+	"\x10shuttle_departed\x18\n" +
+	" \x01(\bR\x0fshuttleDeparted\"1\n" +
+	"\x12GetCampaignRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"\x84\x01\n" +
+	"\x13GetCampaignResponse\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x1b\n" +
+	"\tgame_date\x18\x02 \x01(\tR\bgameDate\x12\x14\n" +
+	"\x05rules\x18\x03 \x03(\tR\x05rules\x12(\n" +
+	"\x04days\x18\x04 \x03(\v2\x14.game.v1.CampaignDayR\x04days\"\x92\x01\n" +
+	"\vCampaignDay\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x1b\n" +
+	"\tgame_date\x18\x02 \x01(\tR\bgameDate\x12\x14\n" +
+	"\x05rules\x18\x03 \x03(\tR\x05rules\x12\x1f\n" +
+	"\vstory_event\x18\x04 \x01(\tR\n" +
+	"storyEvent\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\"_\n" +
+	"\x15GetShiftReportRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12'\n" +
+	"\x0finclude_summary\x18\x02 \x01(\bR\x0eincludeSummary\"\xed\x02\n" +
+	"\x16GetShiftReportResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tgame_date\x18\x02 \x01(\tR\bgameDate\x12\x1f\n" +
+	"\vtotal_score\x18\x03 \x01(\x05R\n" +
+	"totalScore\x12+\n" +
+	"\x11correct_decisions\x18\x04 \x01(\x05R\x10correctDecisions\x12/\n" +
+	"\x13incorrect_decisions\x18\x05 \x01(\x05R\x12incorrectDecisions\x12\x1c\n" +
+	"\tcitations\x18\x06 \x01(\x05R\tcitations\x12\x1f\n" +
+	"\vtotal_cases\x18\a \x01(\x05R\n" +
+	"totalCases\x12*\n" +
+	"\x05cases\x18\b \x03(\v2\x14.game.v1.CaseDebriefR\x05cases\x12-\n" +
+	"\x12supervisor_summary\x18\t \x01(\tR\x11supervisorSummary\"\xdf\x03\n" +
+	"\vCaseDebrief\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12\x19\n" +
+	"\bnpc_name\x18\x02 \x01(\tR\anpcName\x12:\n" +
+	"\x0fplayer_decision\x18\x03 \x01(\x0e2\x11.game.v1.DecisionR\x0eplayerDecision\x12<\n" +
+	"\x10correct_decision\x18\x04 \x01(\x0e2\x11.game.v1.DecisionR\x0fcorrectDecision\x12\x18\n" +
+	"\acorrect\x18\x05 \x01(\bR\acorrect\x12?\n" +
+	"\x11caught_violations\x18\x06 \x03(\v2\x12.game.v1.ViolationR\x10caughtViolations\x12?\n" +
+	"\x11missed_violations\x18\a \x03(\v2\x12.game.v1.ViolationR\x10missedViolations\x12'\n" +
+	"\x0fquestions_asked\x18\b \x03(\tR\x0equestionsAsked\x122\n" +
+	"\x15secondary_checks_used\x18\t \x01(\x05R\x13secondaryChecksUsed\x12)\n" +
+	"\x10decision_seconds\x18\n" +
+	" \x01(\x05R\x0fdecisionSeconds\"Z\n" +
+	"\tViolation\x12#\n" +
+	"\rdocument_type\x18\x01 \x01(\tR\fdocumentType\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\"\x8b\x01\n" +
+	"\x14ResumeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x127\n" +
+	"\faudio_format\x18\x03 \x01(\x0e2\x14.game.v1.AudioFormatR\vaudioFormat\"\xb0\x05\n" +
+	"\x15ResumeSessionResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tgame_date\x18\x02 \x01(\tR\bgameDate\x12\x14\n" +
+	"\x05rules\x18\x03 \x03(\tR\x05rules\x12?\n" +
+	"\fcurrent_case\x18\x04 \x01(\v2\x1c.game.v1.GetNextCaseResponseR\vcurrentCase\x125\n" +
+	"\n" +
+	"transcript\x18\x05 \x03(\v2\x15.game.v1.DialogueLineR\n" +
+	"transcript\x12\x1f\n" +
+	"\vtotal_score\x18\x06 \x01(\x05R\n" +
+	"totalScore\x12+\n" +
+	"\x11correct_decisions\x18\a \x01(\x05R\x10correctDecisions\x12/\n" +
+	"\x13incorrect_decisions\x18\b \x01(\x05R\x12incorrectDecisions\x12<\n" +
+	"\x1aremaining_secondary_checks\x18\t \x01(\x05R\x18remainingSecondaryChecks\x12'\n" +
+	"\x0fcases_completed\x18\n" +
+	" \x01(\x05R\x0ecasesCompleted\x12\x1f\n" +
+	"\vtotal_cases\x18\v \x01(\x05R\n" +
+	"totalCases\x12)\n" +
+	"\x10session_complete\x18\f \x01(\bR\x0fsessionComplete\x12!\n" +
+	"\fcampaign_day\x18\r \x01(\x05R\vcampaignDay\x12\x1f\n" +
+	"\vstory_event\x18\x0e \x01(\tR\n" +
+	"storyEvent\x126\n" +
+	"\x17remaining_shift_seconds\x18\x0f \x01(\x05R\x15remainingShiftSeconds\x12\x1f\n" +
+	"\vshift_clock\x18\x10 \x01(\tR\n" +
+	"shiftClock\"B\n" +
+	"\fDialogueLine\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\"]\n" +
+	"\x15ListMySessionsRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12'\n" +
+	"\x0funfinished_only\x18\x02 \x01(\bR\x0eunfinishedOnly\"M\n" +
+	"\x16ListMySessionsResponse\x123\n" +
+	"\bsessions\x18\x01 \x03(\v2\x17.game.v1.SessionSummaryR\bsessions\"\xa4\x02\n" +
+	"\x0eSessionSummary\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tgame_date\x18\x02 \x01(\tR\bgameDate\x12!\n" +
+	"\fcampaign_day\x18\x03 \x01(\x05R\vcampaignDay\x12'\n" +
+	"\x0fcases_completed\x18\x04 \x01(\x05R\x0ecasesCompleted\x12\x1f\n" +
+	"\vtotal_cases\x18\x05 \x01(\x05R\n" +
+	"totalCases\x12\x1f\n" +
+	"\vtotal_score\x18\x06 \x01(\x05R\n" +
+	"totalScore\x12)\n" +
+	"\x10session_complete\x18\a \x01(\bR\x0fsessionComplete\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\x03R\tstartedAt\"\xfd\x01\n" +
 	"\n" +
 	"NPCProfile\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
@@ -1340,7 +4213,9 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"department\x12 \n" +
 	"\vpersonality\x18\x04 \x01(\tR\vpersonality\x12!\n" +
 	"\fportrait_url\x18\x05 \x01(\tR\vportraitUrl\x12\x1a\n" +
-	"\bdemeanor\x18\x06 \x01(\tR\bdemeanor\"\xaf\x01\n" +
+	"\bdemeanor\x18\x06 \x01(\tR\bdemeanor\x12\x15\n" +
+	"\x06npc_id\x18\a \x01(\tR\x05npcId\x12/\n" +
+	"\x13previous_encounters\x18\b \x01(\x05R\x12previousEncounters\"\xaf\x01\n" +
 	"\bDocument\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x125\n" +
 	"\x06fields\x18\x02 \x03(\v2\x1d.game.v1.Document.FieldsEntryR\x06fields\x12\x1d\n" +
@@ -1348,7 +4223,47 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"visual_url\x18\x03 \x01(\tR\tvisualUrl\x1a9\n" +
 	"\vFieldsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xa9\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\">\n" +
+	"\x11ListVoicesRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"@\n" +
+	"\x12ListVoicesResponse\x12*\n" +
+	"\x06voices\x18\x01 \x03(\v2\x12.game.v1.VoiceInfoR\x06voices\"\xb3\x02\n" +
+	"\tVoiceInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tvocal_age\x18\x05 \x01(\tR\bvocalAge\x12\x14\n" +
+	"\x05pitch\x18\x06 \x01(\tR\x05pitch\x12\x18\n" +
+	"\atimbres\x18\a \x03(\tR\atimbres\x12\x16\n" +
+	"\x06accent\x18\b \x01(\tR\x06accent\x12\x16\n" +
+	"\x06energy\x18\t \x01(\tR\x06energy\x12\x1c\n" +
+	"\tlanguages\x18\n" +
+	" \x03(\tR\tlanguages\x12+\n" +
+	"\x11emotion_overrides\x18\v \x03(\tR\x10emotionOverrides\"M\n" +
+	"\x0fGetUsageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\x9e\x01\n" +
+	"\x10GetUsageResponse\x12,\n" +
+	"\x06server\x18\x01 \x01(\v2\x14.game.v1.UsageTotalsR\x06server\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.game.v1.UsageTotalsR\asession\x12,\n" +
+	"\x06player\x18\x03 \x01(\v2\x14.game.v1.UsageTotalsR\x06player\"\xe6\x01\n" +
+	"\vUsageTotals\x12\x1b\n" +
+	"\tllm_calls\x18\x01 \x01(\x03R\bllmCalls\x12#\n" +
+	"\rprompt_tokens\x18\x02 \x01(\x03R\fpromptTokens\x12#\n" +
+	"\routput_tokens\x18\x03 \x01(\x03R\foutputTokens\x12\x1b\n" +
+	"\ttts_calls\x18\x04 \x01(\x03R\bttsCalls\x12%\n" +
+	"\x0etts_characters\x18\x05 \x01(\x03R\rttsCharacters\x12,\n" +
+	"\x12estimated_cost_usd\x18\x06 \x01(\x01R\x10estimatedCostUsd*\xc7\x01\n" +
+	"\vAudioFormat\x12\x1c\n" +
+	"\x18AUDIO_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13AUDIO_FORMAT_MP3_64\x10\x01\x12\x18\n" +
+	"\x14AUDIO_FORMAT_MP3_128\x10\x02\x12\x18\n" +
+	"\x14AUDIO_FORMAT_MP3_192\x10\x03\x12\x15\n" +
+	"\x11AUDIO_FORMAT_OPUS\x10\x04\x12\x1a\n" +
+	"\x16AUDIO_FORMAT_PCM_16000\x10\x05\x12\x1a\n" +
+	"\x16AUDIO_FORMAT_PCM_24000\x10\x06*\xa9\x01\n" +
 	"\vCaseOutcome\x12\x1c\n" +
 	"\x18CASE_OUTCOME_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cCASE_OUTCOME_CORRECT_APPROVE\x10\x01\x12\x1d\n" +
@@ -1359,15 +4274,27 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x14DECISION_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10DECISION_APPROVE\x10\x01\x12\x11\n" +
 	"\rDECISION_DENY\x10\x02\x12\x16\n" +
-	"\x12DECISION_SECONDARY\x10\x032\xe8\x03\n" +
+	"\x12DECISION_SECONDARY\x10\x032\x87\v\n" +
 	"\vGameService\x12M\n" +
-	"\fStartSession\x12\x1c.game.v1.StartSessionRequest\x1a\x1d.game.v1.StartSessionResponse0\x01\x12H\n" +
+	"\fStartSession\x12\x1c.game.v1.StartSessionRequest\x1a\x1d.game.v1.StartSessionResponse0\x01\x12_\n" +
+	"\x16StartSessionGeneration\x12\x1c.game.v1.StartSessionRequest\x1a'.game.v1.StartSessionGenerationResponse\x12a\n" +
+	"\x16WatchSessionGeneration\x12&.game.v1.WatchSessionGenerationRequest\x1a\x1d.game.v1.StartSessionResponse0\x01\x12l\n" +
+	"\x17CancelSessionGeneration\x12'.game.v1.CancelSessionGenerationRequest\x1a(.game.v1.CancelSessionGenerationResponse\x12H\n" +
 	"\vGetNextCase\x12\x1b.game.v1.GetNextCaseRequest\x1a\x1c.game.v1.GetNextCaseResponse\x12J\n" +
-	"\vAskQuestion\x12\x1b.game.v1.AskQuestionRequest\x1a\x1c.game.v1.AskQuestionResponse0\x01\x12Q\n" +
+	"\vAskQuestion\x12\x1b.game.v1.AskQuestionRequest\x1a\x1c.game.v1.AskQuestionResponse0\x01\x12U\n" +
+	"\x12AskQuestionByVoice\x12\x1d.game.v1.VoiceQuestionRequest\x1a\x1c.game.v1.AskQuestionResponse(\x010\x01\x12L\n" +
+	"\vInterrogate\x12\x1b.game.v1.InterrogateRequest\x1a\x1c.game.v1.InterrogateResponse(\x010\x01\x12Q\n" +
 	"\x0eSecondaryCheck\x12\x1e.game.v1.SecondaryCheckRequest\x1a\x1f.game.v1.SecondaryCheckResponse\x12H\n" +
 	"\vResolveCase\x12\x1b.game.v1.ResolveCaseRequest\x1a\x1c.game.v1.ResolveCaseResponse\x12W\n" +
-	"\x10GetSessionStatus\x12 .game.v1.GetSessionStatusRequest\x1a!.game.v1.GetSessionStatusResponseB\x89\x01\n" +
-	"\vcom.game.v1B\tGameProtoP\x01Z2github.com/ttrubel/send-me-home/gen/game/v1;gamev1\xa2\x02\x03GXX\xaa\x02\aGame.V1\xca\x02\aGame\\V1\xe2\x02\x13Game\\V1\\GPBMetadata\xea\x02\bGame::V1b\x06proto3"
+	"\x10GetSessionStatus\x12 .game.v1.GetSessionStatusRequest\x1a!.game.v1.GetSessionStatusResponse\x12H\n" +
+	"\vGetCampaign\x12\x1b.game.v1.GetCampaignRequest\x1a\x1c.game.v1.GetCampaignResponse\x12Q\n" +
+	"\x0eGetShiftReport\x12\x1e.game.v1.GetShiftReportRequest\x1a\x1f.game.v1.GetShiftReportResponse\x12N\n" +
+	"\rResumeSession\x12\x1d.game.v1.ResumeSessionRequest\x1a\x1e.game.v1.ResumeSessionResponse\x12Q\n" +
+	"\x0eListMySessions\x12\x1e.game.v1.ListMySessionsRequest\x1a\x1f.game.v1.ListMySessionsResponse\x12E\n" +
+	"\n" +
+	"ListVoices\x12\x1a.game.v1.ListVoicesRequest\x1a\x1b.game.v1.ListVoicesResponse\x12?\n" +
+	"\bGetUsage\x12\x18.game.v1.GetUsageRequest\x1a\x19.game.v1.GetUsageResponseB\x89\x01\n" +
+	"\vcom.game.v1B\tGameProtoP\x01Z2github.com/ttrubel/send-me-home/gen/game/v1;gamev1\xa2\x02\x03GVX\xaa\x02\aGame.V1\xca\x02\aGame\\V1\xe2\x02\x13Game\\V1\\GPBMetadata\xea\x02\bGame::V1b\x06proto3"
 
 var (
 	file_game_v1_game_proto_rawDescOnce sync.Once
@@ -1381,54 +4308,136 @@ func file_game_v1_game_proto_rawDescGZIP() []byte {
 	return file_game_v1_game_proto_rawDescData
 }
 
-var file_game_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_game_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_game_v1_game_proto_goTypes = []any{
-	(CaseOutcome)(0),                 // 0: game.v1.CaseOutcome
-	(Decision)(0),                    // 1: game.v1.Decision
-	(*StartSessionRequest)(nil),      // 2: game.v1.StartSessionRequest
-	(*StartSessionResponse)(nil),     // 3: game.v1.StartSessionResponse
-	(*SessionProgress)(nil),          // 4: game.v1.SessionProgress
-	(*SessionReady)(nil),             // 5: game.v1.SessionReady
-	(*GetNextCaseRequest)(nil),       // 6: game.v1.GetNextCaseRequest
-	(*GetNextCaseResponse)(nil),      // 7: game.v1.GetNextCaseResponse
-	(*AskQuestionRequest)(nil),       // 8: game.v1.AskQuestionRequest
-	(*AskQuestionResponse)(nil),      // 9: game.v1.AskQuestionResponse
-	(*SecondaryCheckRequest)(nil),    // 10: game.v1.SecondaryCheckRequest
-	(*SecondaryCheckResponse)(nil),   // 11: game.v1.SecondaryCheckResponse
-	(*ResolveCaseRequest)(nil),       // 12: game.v1.ResolveCaseRequest
-	(*ResolveCaseResponse)(nil),      // 13: game.v1.ResolveCaseResponse
-	(*GetSessionStatusRequest)(nil),  // 14: game.v1.GetSessionStatusRequest
-	(*GetSessionStatusResponse)(nil), // 15: game.v1.GetSessionStatusResponse
-	(*NPCProfile)(nil),               // 16: game.v1.NPCProfile
-	(*Document)(nil),                 // 17: game.v1.Document
-	nil,                              // 18: game.v1.Document.FieldsEntry
+	(AudioFormat)(0),                        // 0: game.v1.AudioFormat
+	(CaseOutcome)(0),                        // 1: game.v1.CaseOutcome
+	(Decision)(0),                           // 2: game.v1.Decision
+	(*StartSessionRequest)(nil),             // 3: game.v1.StartSessionRequest
+	(*StartSessionResponse)(nil),            // 4: game.v1.StartSessionResponse
+	(*SessionProgress)(nil),                 // 5: game.v1.SessionProgress
+	(*SessionReady)(nil),                    // 6: game.v1.SessionReady
+	(*StartSessionGenerationResponse)(nil),  // 7: game.v1.StartSessionGenerationResponse
+	(*WatchSessionGenerationRequest)(nil),   // 8: game.v1.WatchSessionGenerationRequest
+	(*CancelSessionGenerationRequest)(nil),  // 9: game.v1.CancelSessionGenerationRequest
+	(*CancelSessionGenerationResponse)(nil), // 10: game.v1.CancelSessionGenerationResponse
+	(*GetNextCaseRequest)(nil),              // 11: game.v1.GetNextCaseRequest
+	(*GetNextCaseResponse)(nil),             // 12: game.v1.GetNextCaseResponse
+	(*SubtitleTrack)(nil),                   // 13: game.v1.SubtitleTrack
+	(*SubtitleWord)(nil),                    // 14: game.v1.SubtitleWord
+	(*AskQuestionRequest)(nil),              // 15: game.v1.AskQuestionRequest
+	(*AskQuestionResponse)(nil),             // 16: game.v1.AskQuestionResponse
+	(*VoiceQuestionRequest)(nil),            // 17: game.v1.VoiceQuestionRequest
+	(*VoiceQuestionStart)(nil),              // 18: game.v1.VoiceQuestionStart
+	(*InterrogateRequest)(nil),              // 19: game.v1.InterrogateRequest
+	(*InterrogateStart)(nil),                // 20: game.v1.InterrogateStart
+	(*InterrogateResponse)(nil),             // 21: game.v1.InterrogateResponse
+	(*SecondaryCheckRequest)(nil),           // 22: game.v1.SecondaryCheckRequest
+	(*SecondaryCheckResponse)(nil),          // 23: game.v1.SecondaryCheckResponse
+	(*ResolveCaseRequest)(nil),              // 24: game.v1.ResolveCaseRequest
+	(*FlaggedField)(nil),                    // 25: game.v1.FlaggedField
+	(*ResolveCaseResponse)(nil),             // 26: game.v1.ResolveCaseResponse
+	(*ScoreItem)(nil),                       // 27: game.v1.ScoreItem
+	(*GetSessionStatusRequest)(nil),         // 28: game.v1.GetSessionStatusRequest
+	(*GetSessionStatusResponse)(nil),        // 29: game.v1.GetSessionStatusResponse
+	(*GetCampaignRequest)(nil),              // 30: game.v1.GetCampaignRequest
+	(*GetCampaignResponse)(nil),             // 31: game.v1.GetCampaignResponse
+	(*CampaignDay)(nil),                     // 32: game.v1.CampaignDay
+	(*GetShiftReportRequest)(nil),           // 33: game.v1.GetShiftReportRequest
+	(*GetShiftReportResponse)(nil),          // 34: game.v1.GetShiftReportResponse
+	(*CaseDebrief)(nil),                     // 35: game.v1.CaseDebrief
+	(*Violation)(nil),                       // 36: game.v1.Violation
+	(*ResumeSessionRequest)(nil),            // 37: game.v1.ResumeSessionRequest
+	(*ResumeSessionResponse)(nil),           // 38: game.v1.ResumeSessionResponse
+	(*DialogueLine)(nil),                    // 39: game.v1.DialogueLine
+	(*ListMySessionsRequest)(nil),           // 40: game.v1.ListMySessionsRequest
+	(*ListMySessionsResponse)(nil),          // 41: game.v1.ListMySessionsResponse
+	(*SessionSummary)(nil),                  // 42: game.v1.SessionSummary
+	(*NPCProfile)(nil),                      // 43: game.v1.NPCProfile
+	(*Document)(nil),                        // 44: game.v1.Document
+	(*ListVoicesRequest)(nil),               // 45: game.v1.ListVoicesRequest
+	(*ListVoicesResponse)(nil),              // 46: game.v1.ListVoicesResponse
+	(*VoiceInfo)(nil),                       // 47: game.v1.VoiceInfo
+	(*GetUsageRequest)(nil),                 // 48: game.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),                // 49: game.v1.GetUsageResponse
+	(*UsageTotals)(nil),                     // 50: game.v1.UsageTotals
+	nil,                                     // 51: game.v1.Document.FieldsEntry
 }
 var file_game_v1_game_proto_depIdxs = []int32{
-	4,  // 0: game.v1.StartSessionResponse.progress:type_name -> game.v1.SessionProgress
-	5,  // 1: game.v1.StartSessionResponse.ready:type_name -> game.v1.SessionReady
-	16, // 2: game.v1.GetNextCaseResponse.npc:type_name -> game.v1.NPCProfile
-	17, // 3: game.v1.GetNextCaseResponse.documents:type_name -> game.v1.Document
-	1,  // 4: game.v1.ResolveCaseRequest.decision:type_name -> game.v1.Decision
-	0,  // 5: game.v1.ResolveCaseResponse.outcome:type_name -> game.v1.CaseOutcome
-	18, // 6: game.v1.Document.fields:type_name -> game.v1.Document.FieldsEntry
-	2,  // 7: game.v1.GameService.StartSession:input_type -> game.v1.StartSessionRequest
-	6,  // 8: game.v1.GameService.GetNextCase:input_type -> game.v1.GetNextCaseRequest
-	8,  // 9: game.v1.GameService.AskQuestion:input_type -> game.v1.AskQuestionRequest
-	10, // 10: game.v1.GameService.SecondaryCheck:input_type -> game.v1.SecondaryCheckRequest
-	12, // 11: game.v1.GameService.ResolveCase:input_type -> game.v1.ResolveCaseRequest
-	14, // 12: game.v1.GameService.GetSessionStatus:input_type -> game.v1.GetSessionStatusRequest
-	3,  // 13: game.v1.GameService.StartSession:output_type -> game.v1.StartSessionResponse
-	7,  // 14: game.v1.GameService.GetNextCase:output_type -> game.v1.GetNextCaseResponse
-	9,  // 15: game.v1.GameService.AskQuestion:output_type -> game.v1.AskQuestionResponse
-	11, // 16: game.v1.GameService.SecondaryCheck:output_type -> game.v1.SecondaryCheckResponse
-	13, // 17: game.v1.GameService.ResolveCase:output_type -> game.v1.ResolveCaseResponse
-	15, // 18: game.v1.GameService.GetSessionStatus:output_type -> game.v1.GetSessionStatusResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	5,  // 0: game.v1.StartSessionResponse.progress:type_name -> game.v1.SessionProgress
+	6,  // 1: game.v1.StartSessionResponse.ready:type_name -> game.v1.SessionReady
+	0,  // 2: game.v1.GetNextCaseRequest.audio_format:type_name -> game.v1.AudioFormat
+	43, // 3: game.v1.GetNextCaseResponse.npc:type_name -> game.v1.NPCProfile
+	44, // 4: game.v1.GetNextCaseResponse.documents:type_name -> game.v1.Document
+	13, // 5: game.v1.GetNextCaseResponse.opening_subtitles:type_name -> game.v1.SubtitleTrack
+	14, // 6: game.v1.SubtitleTrack.words:type_name -> game.v1.SubtitleWord
+	0,  // 7: game.v1.AskQuestionRequest.audio_format:type_name -> game.v1.AudioFormat
+	13, // 8: game.v1.AskQuestionResponse.subtitles:type_name -> game.v1.SubtitleTrack
+	18, // 9: game.v1.VoiceQuestionRequest.start:type_name -> game.v1.VoiceQuestionStart
+	0,  // 10: game.v1.VoiceQuestionStart.audio_format:type_name -> game.v1.AudioFormat
+	20, // 11: game.v1.InterrogateRequest.start:type_name -> game.v1.InterrogateStart
+	0,  // 12: game.v1.InterrogateStart.audio_format:type_name -> game.v1.AudioFormat
+	2,  // 13: game.v1.ResolveCaseRequest.decision:type_name -> game.v1.Decision
+	25, // 14: game.v1.ResolveCaseRequest.flagged_fields:type_name -> game.v1.FlaggedField
+	0,  // 15: game.v1.ResolveCaseRequest.audio_format:type_name -> game.v1.AudioFormat
+	1,  // 16: game.v1.ResolveCaseResponse.outcome:type_name -> game.v1.CaseOutcome
+	27, // 17: game.v1.ResolveCaseResponse.score_breakdown:type_name -> game.v1.ScoreItem
+	13, // 18: game.v1.ResolveCaseResponse.reaction_subtitles:type_name -> game.v1.SubtitleTrack
+	32, // 19: game.v1.GetCampaignResponse.days:type_name -> game.v1.CampaignDay
+	35, // 20: game.v1.GetShiftReportResponse.cases:type_name -> game.v1.CaseDebrief
+	2,  // 21: game.v1.CaseDebrief.player_decision:type_name -> game.v1.Decision
+	2,  // 22: game.v1.CaseDebrief.correct_decision:type_name -> game.v1.Decision
+	36, // 23: game.v1.CaseDebrief.caught_violations:type_name -> game.v1.Violation
+	36, // 24: game.v1.CaseDebrief.missed_violations:type_name -> game.v1.Violation
+	0,  // 25: game.v1.ResumeSessionRequest.audio_format:type_name -> game.v1.AudioFormat
+	12, // 26: game.v1.ResumeSessionResponse.current_case:type_name -> game.v1.GetNextCaseResponse
+	39, // 27: game.v1.ResumeSessionResponse.transcript:type_name -> game.v1.DialogueLine
+	42, // 28: game.v1.ListMySessionsResponse.sessions:type_name -> game.v1.SessionSummary
+	51, // 29: game.v1.Document.fields:type_name -> game.v1.Document.FieldsEntry
+	47, // 30: game.v1.ListVoicesResponse.voices:type_name -> game.v1.VoiceInfo
+	50, // 31: game.v1.GetUsageResponse.server:type_name -> game.v1.UsageTotals
+	50, // 32: game.v1.GetUsageResponse.session:type_name -> game.v1.UsageTotals
+	50, // 33: game.v1.GetUsageResponse.player:type_name -> game.v1.UsageTotals
+	3,  // 34: game.v1.GameService.StartSession:input_type -> game.v1.StartSessionRequest
+	3,  // 35: game.v1.GameService.StartSessionGeneration:input_type -> game.v1.StartSessionRequest
+	8,  // 36: game.v1.GameService.WatchSessionGeneration:input_type -> game.v1.WatchSessionGenerationRequest
+	9,  // 37: game.v1.GameService.CancelSessionGeneration:input_type -> game.v1.CancelSessionGenerationRequest
+	11, // 38: game.v1.GameService.GetNextCase:input_type -> game.v1.GetNextCaseRequest
+	15, // 39: game.v1.GameService.AskQuestion:input_type -> game.v1.AskQuestionRequest
+	17, // 40: game.v1.GameService.AskQuestionByVoice:input_type -> game.v1.VoiceQuestionRequest
+	19, // 41: game.v1.GameService.Interrogate:input_type -> game.v1.InterrogateRequest
+	22, // 42: game.v1.GameService.SecondaryCheck:input_type -> game.v1.SecondaryCheckRequest
+	24, // 43: game.v1.GameService.ResolveCase:input_type -> game.v1.ResolveCaseRequest
+	28, // 44: game.v1.GameService.GetSessionStatus:input_type -> game.v1.GetSessionStatusRequest
+	30, // 45: game.v1.GameService.GetCampaign:input_type -> game.v1.GetCampaignRequest
+	33, // 46: game.v1.GameService.GetShiftReport:input_type -> game.v1.GetShiftReportRequest
+	37, // 47: game.v1.GameService.ResumeSession:input_type -> game.v1.ResumeSessionRequest
+	40, // 48: game.v1.GameService.ListMySessions:input_type -> game.v1.ListMySessionsRequest
+	45, // 49: game.v1.GameService.ListVoices:input_type -> game.v1.ListVoicesRequest
+	48, // 50: game.v1.GameService.GetUsage:input_type -> game.v1.GetUsageRequest
+	4,  // 51: game.v1.GameService.StartSession:output_type -> game.v1.StartSessionResponse
+	7,  // 52: game.v1.GameService.StartSessionGeneration:output_type -> game.v1.StartSessionGenerationResponse
+	4,  // 53: game.v1.GameService.WatchSessionGeneration:output_type -> game.v1.StartSessionResponse
+	10, // 54: game.v1.GameService.CancelSessionGeneration:output_type -> game.v1.CancelSessionGenerationResponse
+	12, // 55: game.v1.GameService.GetNextCase:output_type -> game.v1.GetNextCaseResponse
+	16, // 56: game.v1.GameService.AskQuestion:output_type -> game.v1.AskQuestionResponse
+	16, // 57: game.v1.GameService.AskQuestionByVoice:output_type -> game.v1.AskQuestionResponse
+	21, // 58: game.v1.GameService.Interrogate:output_type -> game.v1.InterrogateResponse
+	23, // 59: game.v1.GameService.SecondaryCheck:output_type -> game.v1.SecondaryCheckResponse
+	26, // 60: game.v1.GameService.ResolveCase:output_type -> game.v1.ResolveCaseResponse
+	29, // 61: game.v1.GameService.GetSessionStatus:output_type -> game.v1.GetSessionStatusResponse
+	31, // 62: game.v1.GameService.GetCampaign:output_type -> game.v1.GetCampaignResponse
+	34, // 63: game.v1.GameService.GetShiftReport:output_type -> game.v1.GetShiftReportResponse
+	38, // 64: game.v1.GameService.ResumeSession:output_type -> game.v1.ResumeSessionResponse
+	41, // 65: game.v1.GameService.ListMySessions:output_type -> game.v1.ListMySessionsResponse
+	46, // 66: game.v1.GameService.ListVoices:output_type -> game.v1.ListVoicesResponse
+	49, // 67: game.v1.GameService.GetUsage:output_type -> game.v1.GetUsageResponse
+	51, // [51:68] is the sub-list for method output_type
+	34, // [34:51] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_game_proto_init() }
@@ -1440,18 +4449,39 @@ func file_game_v1_game_proto_init() {
 		(*StartSessionResponse_Progress)(nil),
 		(*StartSessionResponse_Ready)(nil),
 	}
-	file_game_v1_game_proto_msgTypes[7].OneofWrappers = []any{
+	file_game_v1_game_proto_msgTypes[13].OneofWrappers = []any{
 		(*AskQuestionResponse_TextChunk)(nil),
 		(*AskQuestionResponse_AudioChunk)(nil),
 		(*AskQuestionResponse_Done)(nil),
+		(*AskQuestionResponse_Transcript)(nil),
+		(*AskQuestionResponse_Subtitles)(nil),
+	}
+	file_game_v1_game_proto_msgTypes[14].OneofWrappers = []any{
+		(*VoiceQuestionRequest_Start)(nil),
+		(*VoiceQuestionRequest_AudioChunk)(nil),
+	}
+	file_game_v1_game_proto_msgTypes[16].OneofWrappers = []any{
+		(*InterrogateRequest_Start)(nil),
+		(*InterrogateRequest_Question)(nil),
+		(*InterrogateRequest_AudioChunk)(nil),
+		(*InterrogateRequest_AudioEnd)(nil),
+		(*InterrogateRequest_Interrupt)(nil),
+	}
+	file_game_v1_game_proto_msgTypes[18].OneofWrappers = []any{
+		(*InterrogateResponse_Transcript)(nil),
+		(*InterrogateResponse_TextChunk)(nil),
+		(*InterrogateResponse_AudioChunk)(nil),
+		(*InterrogateResponse_Done)(nil),
+		(*InterrogateResponse_Interrupted)(nil),
+		(*InterrogateResponse_Error)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_game_proto_rawDesc), len(file_game_v1_game_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   17,
+			NumEnums:      3,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,10 +36,24 @@ const (
 	// GameServiceStartSessionProcedure is the fully-qualified name of the GameService's StartSession
 	// RPC.
 	GameServiceStartSessionProcedure = "/game.v1.GameService/StartSession"
+	// GameServiceStartSessionGenerationProcedure is the fully-qualified name of the GameService's
+	// StartSessionGeneration RPC.
+	GameServiceStartSessionGenerationProcedure = "/game.v1.GameService/StartSessionGeneration"
+	// GameServiceWatchSessionGenerationProcedure is the fully-qualified name of the GameService's
+	// WatchSessionGeneration RPC.
+	GameServiceWatchSessionGenerationProcedure = "/game.v1.GameService/WatchSessionGeneration"
+	// GameServiceCancelSessionGenerationProcedure is the fully-qualified name of the GameService's
+	// CancelSessionGeneration RPC.
+	GameServiceCancelSessionGenerationProcedure = "/game.v1.GameService/CancelSessionGeneration"
 	// GameServiceGetNextCaseProcedure is the fully-qualified name of the GameService's GetNextCase RPC.
 	GameServiceGetNextCaseProcedure = "/game.v1.GameService/GetNextCase"
 	// GameServiceAskQuestionProcedure is the fully-qualified name of the GameService's AskQuestion RPC.
 	GameServiceAskQuestionProcedure = "/game.v1.GameService/AskQuestion"
+	// GameServiceAskQuestionByVoiceProcedure is the fully-qualified name of the GameService's
+	// AskQuestionByVoice RPC.
+	GameServiceAskQuestionByVoiceProcedure = "/game.v1.GameService/AskQuestionByVoice"
+	// GameServiceInterrogateProcedure is the fully-qualified name of the GameService's Interrogate RPC.
+	GameServiceInterrogateProcedure = "/game.v1.GameService/Interrogate"
 	// GameServiceSecondaryCheckProcedure is the fully-qualified name of the GameService's
 	// SecondaryCheck RPC.
 	GameServiceSecondaryCheckProcedure = "/game.v1.GameService/SecondaryCheck"
@@ -48,22 +62,59 @@ const (
 	// GameServiceGetSessionStatusProcedure is the fully-qualified name of the GameService's
 	// GetSessionStatus RPC.
 	GameServiceGetSessionStatusProcedure = "/game.v1.GameService/GetSessionStatus"
+	// GameServiceGetCampaignProcedure is the fully-qualified name of the GameService's GetCampaign RPC.
+	GameServiceGetCampaignProcedure = "/game.v1.GameService/GetCampaign"
+	// GameServiceGetShiftReportProcedure is the fully-qualified name of the GameService's
+	// GetShiftReport RPC.
+	GameServiceGetShiftReportProcedure = "/game.v1.GameService/GetShiftReport"
+	// GameServiceResumeSessionProcedure is the fully-qualified name of the GameService's ResumeSession
+	// RPC.
+	GameServiceResumeSessionProcedure = "/game.v1.GameService/ResumeSession"
+	// GameServiceListMySessionsProcedure is the fully-qualified name of the GameService's
+	// ListMySessions RPC.
+	GameServiceListMySessionsProcedure = "/game.v1.GameService/ListMySessions"
+	// GameServiceListVoicesProcedure is the fully-qualified name of the GameService's ListVoices RPC.
+	GameServiceListVoicesProcedure = "/game.v1.GameService/ListVoices"
+	// GameServiceGetUsageProcedure is the fully-qualified name of the GameService's GetUsage RPC.
+	GameServiceGetUsageProcedure = "/game.v1.GameService/GetUsage"
 )
 
 // GameServiceClient is a client for the game.v1.GameService service.
 type GameServiceClient interface {
 	// Start a new session - generates all cases upfront
 	StartSession(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.ServerStreamForClient[v1.StartSessionResponse], error)
-	// Get next case from pre-generated queue
+	// Start generating a session in the background and return its job ID
+	StartSessionGeneration(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionGenerationResponse], error)
+	// Stream a generation job's progress; reconnecting picks up where it left off
+	WatchSessionGeneration(context.Context, *connect.Request[v1.WatchSessionGenerationRequest]) (*connect.ServerStreamForClient[v1.StartSessionResponse], error)
+	// Cancel a running generation job
+	CancelSessionGeneration(context.Context, *connect.Request[v1.CancelSessionGenerationRequest]) (*connect.Response[v1.CancelSessionGenerationResponse], error)
+	// Get next case from pre-generated queue (generated on demand in adaptive mode)
 	GetNextCase(context.Context, *connect.Request[v1.GetNextCaseRequest]) (*connect.Response[v1.GetNextCaseResponse], error)
 	// Ask NPC a question - real-time response with streaming audio
 	AskQuestion(context.Context, *connect.Request[v1.AskQuestionRequest]) (*connect.ServerStreamForClient[v1.AskQuestionResponse], error)
+	// Ask a question by voice: stream microphone audio, get back the transcript and the NPC's answer
+	AskQuestionByVoice(context.Context) *connect.BidiStreamForClient[v1.VoiceQuestionRequest, v1.AskQuestionResponse]
+	// Live conversation with the NPC of one case: a new question or an interrupt cancels the answer in progress
+	Interrogate(context.Context) *connect.BidiStreamForClient[v1.InterrogateRequest, v1.InterrogateResponse]
 	// Use secondary check (limited quota)
 	SecondaryCheck(context.Context, *connect.Request[v1.SecondaryCheckRequest]) (*connect.Response[v1.SecondaryCheckResponse], error)
 	// Resolve case with player decision
 	ResolveCase(context.Context, *connect.Request[v1.ResolveCaseRequest]) (*connect.Response[v1.ResolveCaseResponse], error)
 	// Get session status
 	GetSessionStatus(context.Context, *connect.Request[v1.GetSessionStatusRequest]) (*connect.Response[v1.GetSessionStatusResponse], error)
+	// Get a player's multi-day campaign progress
+	GetCampaign(context.Context, *connect.Request[v1.GetCampaignRequest]) (*connect.Response[v1.GetCampaignResponse], error)
+	// Get the end-of-shift debrief with a per-case breakdown
+	GetShiftReport(context.Context, *connect.Request[v1.GetShiftReportRequest]) (*connect.Response[v1.GetShiftReportResponse], error)
+	// Resume a session after a reload or disconnect
+	ResumeSession(context.Context, *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error)
+	// List a player's sessions, newest first
+	ListMySessions(context.Context, *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error)
+	// Admin: list the voice catalog (requires the admin token)
+	ListVoices(context.Context, *connect.Request[v1.ListVoicesRequest]) (*connect.Response[v1.ListVoicesResponse], error)
+	// Admin: AI usage and estimated cost for the server, a session or a player
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
 }
 
 // NewGameServiceClient constructs a client for the game.v1.GameService service. By default, it uses
@@ -83,6 +134,24 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceMethods.ByName("StartSession")),
 			connect.WithClientOptions(opts...),
 		),
+		startSessionGeneration: connect.NewClient[v1.StartSessionRequest, v1.StartSessionGenerationResponse](
+			httpClient,
+			baseURL+GameServiceStartSessionGenerationProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("StartSessionGeneration")),
+			connect.WithClientOptions(opts...),
+		),
+		watchSessionGeneration: connect.NewClient[v1.WatchSessionGenerationRequest, v1.StartSessionResponse](
+			httpClient,
+			baseURL+GameServiceWatchSessionGenerationProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("WatchSessionGeneration")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelSessionGeneration: connect.NewClient[v1.CancelSessionGenerationRequest, v1.CancelSessionGenerationResponse](
+			httpClient,
+			baseURL+GameServiceCancelSessionGenerationProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("CancelSessionGeneration")),
+			connect.WithClientOptions(opts...),
+		),
 		getNextCase: connect.NewClient[v1.GetNextCaseRequest, v1.GetNextCaseResponse](
 			httpClient,
 			baseURL+GameServiceGetNextCaseProcedure,
@@ -95,6 +164,18 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceMethods.ByName("AskQuestion")),
 			connect.WithClientOptions(opts...),
 		),
+		askQuestionByVoice: connect.NewClient[v1.VoiceQuestionRequest, v1.AskQuestionResponse](
+			httpClient,
+			baseURL+GameServiceAskQuestionByVoiceProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("AskQuestionByVoice")),
+			connect.WithClientOptions(opts...),
+		),
+		interrogate: connect.NewClient[v1.InterrogateRequest, v1.InterrogateResponse](
+			httpClient,
+			baseURL+GameServiceInterrogateProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("Interrogate")),
+			connect.WithClientOptions(opts...),
+		),
 		secondaryCheck: connect.NewClient[v1.SecondaryCheckRequest, v1.SecondaryCheckResponse](
 			httpClient,
 			baseURL+GameServiceSecondaryCheckProcedure,
@@ -113,17 +194,64 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceMethods.ByName("GetSessionStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getCampaign: connect.NewClient[v1.GetCampaignRequest, v1.GetCampaignResponse](
+			httpClient,
+			baseURL+GameServiceGetCampaignProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		getShiftReport: connect.NewClient[v1.GetShiftReportRequest, v1.GetShiftReportResponse](
+			httpClient,
+			baseURL+GameServiceGetShiftReportProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetShiftReport")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSession: connect.NewClient[v1.ResumeSessionRequest, v1.ResumeSessionResponse](
+			httpClient,
+			baseURL+GameServiceResumeSessionProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("ResumeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listMySessions: connect.NewClient[v1.ListMySessionsRequest, v1.ListMySessionsResponse](
+			httpClient,
+			baseURL+GameServiceListMySessionsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("ListMySessions")),
+			connect.WithClientOptions(opts...),
+		),
+		listVoices: connect.NewClient[v1.ListVoicesRequest, v1.ListVoicesResponse](
+			httpClient,
+			baseURL+GameServiceListVoicesProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("ListVoices")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[v1.GetUsageRequest, v1.GetUsageResponse](
+			httpClient,
+			baseURL+GameServiceGetUsageProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetUsage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gameServiceClient implements GameServiceClient.
 type gameServiceClient struct {
-	startSession     *connect.Client[v1.StartSessionRequest, v1.StartSessionResponse]
-	getNextCase      *connect.Client[v1.GetNextCaseRequest, v1.GetNextCaseResponse]
-	askQuestion      *connect.Client[v1.AskQuestionRequest, v1.AskQuestionResponse]
-	secondaryCheck   *connect.Client[v1.SecondaryCheckRequest, v1.SecondaryCheckResponse]
-	resolveCase      *connect.Client[v1.ResolveCaseRequest, v1.ResolveCaseResponse]
-	getSessionStatus *connect.Client[v1.GetSessionStatusRequest, v1.GetSessionStatusResponse]
+	startSession            *connect.Client[v1.StartSessionRequest, v1.StartSessionResponse]
+	startSessionGeneration  *connect.Client[v1.StartSessionRequest, v1.StartSessionGenerationResponse]
+	watchSessionGeneration  *connect.Client[v1.WatchSessionGenerationRequest, v1.StartSessionResponse]
+	cancelSessionGeneration *connect.Client[v1.CancelSessionGenerationRequest, v1.CancelSessionGenerationResponse]
+	getNextCase             *connect.Client[v1.GetNextCaseRequest, v1.GetNextCaseResponse]
+	askQuestion             *connect.Client[v1.AskQuestionRequest, v1.AskQuestionResponse]
+	askQuestionByVoice      *connect.Client[v1.VoiceQuestionRequest, v1.AskQuestionResponse]
+	interrogate             *connect.Client[v1.InterrogateRequest, v1.InterrogateResponse]
+	secondaryCheck          *connect.Client[v1.SecondaryCheckRequest, v1.SecondaryCheckResponse]
+	resolveCase             *connect.Client[v1.ResolveCaseRequest, v1.ResolveCaseResponse]
+	getSessionStatus        *connect.Client[v1.GetSessionStatusRequest, v1.GetSessionStatusResponse]
+	getCampaign             *connect.Client[v1.GetCampaignRequest, v1.GetCampaignResponse]
+	getShiftReport          *connect.Client[v1.GetShiftReportRequest, v1.GetShiftReportResponse]
+	resumeSession           *connect.Client[v1.ResumeSessionRequest, v1.ResumeSessionResponse]
+	listMySessions          *connect.Client[v1.ListMySessionsRequest, v1.ListMySessionsResponse]
+	listVoices              *connect.Client[v1.ListVoicesRequest, v1.ListVoicesResponse]
+	getUsage                *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
 }
 
 // StartSession calls game.v1.GameService.StartSession.
@@ -131,6 +259,21 @@ func (c *gameServiceClient) StartSession(ctx context.Context, req *connect.Reque
 	return c.startSession.CallServerStream(ctx, req)
 }
 
+// StartSessionGeneration calls game.v1.GameService.StartSessionGeneration.
+func (c *gameServiceClient) StartSessionGeneration(ctx context.Context, req *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionGenerationResponse], error) {
+	return c.startSessionGeneration.CallUnary(ctx, req)
+}
+
+// WatchSessionGeneration calls game.v1.GameService.WatchSessionGeneration.
+func (c *gameServiceClient) WatchSessionGeneration(ctx context.Context, req *connect.Request[v1.WatchSessionGenerationRequest]) (*connect.ServerStreamForClient[v1.StartSessionResponse], error) {
+	return c.watchSessionGeneration.CallServerStream(ctx, req)
+}
+
+// CancelSessionGeneration calls game.v1.GameService.CancelSessionGeneration.
+func (c *gameServiceClient) CancelSessionGeneration(ctx context.Context, req *connect.Request[v1.CancelSessionGenerationRequest]) (*connect.Response[v1.CancelSessionGenerationResponse], error) {
+	return c.cancelSessionGeneration.CallUnary(ctx, req)
+}
+
 // GetNextCase calls game.v1.GameService.GetNextCase.
 func (c *gameServiceClient) GetNextCase(ctx context.Context, req *connect.Request[v1.GetNextCaseRequest]) (*connect.Response[v1.GetNextCaseResponse], error) {
 	return c.getNextCase.CallUnary(ctx, req)
@@ -141,6 +284,16 @@ func (c *gameServiceClient) AskQuestion(ctx context.Context, req *connect.Reques
 	return c.askQuestion.CallServerStream(ctx, req)
 }
 
+// AskQuestionByVoice calls game.v1.GameService.AskQuestionByVoice.
+func (c *gameServiceClient) AskQuestionByVoice(ctx context.Context) *connect.BidiStreamForClient[v1.VoiceQuestionRequest, v1.AskQuestionResponse] {
+	return c.askQuestionByVoice.CallBidiStream(ctx)
+}
+
+// Interrogate calls game.v1.GameService.Interrogate.
+func (c *gameServiceClient) Interrogate(ctx context.Context) *connect.BidiStreamForClient[v1.InterrogateRequest, v1.InterrogateResponse] {
+	return c.interrogate.CallBidiStream(ctx)
+}
+
 // SecondaryCheck calls game.v1.GameService.SecondaryCheck.
 func (c *gameServiceClient) SecondaryCheck(ctx context.Context, req *connect.Request[v1.SecondaryCheckRequest]) (*connect.Response[v1.SecondaryCheckResponse], error) {
 	return c.secondaryCheck.CallUnary(ctx, req)
@@ -156,20 +309,72 @@ func (c *gameServiceClient) GetSessionStatus(ctx context.Context, req *connect.R
 	return c.getSessionStatus.CallUnary(ctx, req)
 }
 
+// GetCampaign calls game.v1.GameService.GetCampaign.
+func (c *gameServiceClient) GetCampaign(ctx context.Context, req *connect.Request[v1.GetCampaignRequest]) (*connect.Response[v1.GetCampaignResponse], error) {
+	return c.getCampaign.CallUnary(ctx, req)
+}
+
+// GetShiftReport calls game.v1.GameService.GetShiftReport.
+func (c *gameServiceClient) GetShiftReport(ctx context.Context, req *connect.Request[v1.GetShiftReportRequest]) (*connect.Response[v1.GetShiftReportResponse], error) {
+	return c.getShiftReport.CallUnary(ctx, req)
+}
+
+// ResumeSession calls game.v1.GameService.ResumeSession.
+func (c *gameServiceClient) ResumeSession(ctx context.Context, req *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error) {
+	return c.resumeSession.CallUnary(ctx, req)
+}
+
+// ListMySessions calls game.v1.GameService.ListMySessions.
+func (c *gameServiceClient) ListMySessions(ctx context.Context, req *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error) {
+	return c.listMySessions.CallUnary(ctx, req)
+}
+
+// ListVoices calls game.v1.GameService.ListVoices.
+func (c *gameServiceClient) ListVoices(ctx context.Context, req *connect.Request[v1.ListVoicesRequest]) (*connect.Response[v1.ListVoicesResponse], error) {
+	return c.listVoices.CallUnary(ctx, req)
+}
+
+// GetUsage calls game.v1.GameService.GetUsage.
+func (c *gameServiceClient) GetUsage(ctx context.Context, req *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
 // GameServiceHandler is an implementation of the game.v1.GameService service.
 type GameServiceHandler interface {
 	// Start a new session - generates all cases upfront
 	StartSession(context.Context, *connect.Request[v1.StartSessionRequest], *connect.ServerStream[v1.StartSessionResponse]) error
-	// Get next case from pre-generated queue
+	// Start generating a session in the background and return its job ID
+	StartSessionGeneration(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionGenerationResponse], error)
+	// Stream a generation job's progress; reconnecting picks up where it left off
+	WatchSessionGeneration(context.Context, *connect.Request[v1.WatchSessionGenerationRequest], *connect.ServerStream[v1.StartSessionResponse]) error
+	// Cancel a running generation job
+	CancelSessionGeneration(context.Context, *connect.Request[v1.CancelSessionGenerationRequest]) (*connect.Response[v1.CancelSessionGenerationResponse], error)
+	// Get next case from pre-generated queue (generated on demand in adaptive mode)
 	GetNextCase(context.Context, *connect.Request[v1.GetNextCaseRequest]) (*connect.Response[v1.GetNextCaseResponse], error)
 	// Ask NPC a question - real-time response with streaming audio
 	AskQuestion(context.Context, *connect.Request[v1.AskQuestionRequest], *connect.ServerStream[v1.AskQuestionResponse]) error
+	// Ask a question by voice: stream microphone audio, get back the transcript and the NPC's answer
+	AskQuestionByVoice(context.Context, *connect.BidiStream[v1.VoiceQuestionRequest, v1.AskQuestionResponse]) error
+	// Live conversation with the NPC of one case: a new question or an interrupt cancels the answer in progress
+	Interrogate(context.Context, *connect.BidiStream[v1.InterrogateRequest, v1.InterrogateResponse]) error
 	// Use secondary check (limited quota)
 	SecondaryCheck(context.Context, *connect.Request[v1.SecondaryCheckRequest]) (*connect.Response[v1.SecondaryCheckResponse], error)
 	// Resolve case with player decision
 	ResolveCase(context.Context, *connect.Request[v1.ResolveCaseRequest]) (*connect.Response[v1.ResolveCaseResponse], error)
 	// Get session status
 	GetSessionStatus(context.Context, *connect.Request[v1.GetSessionStatusRequest]) (*connect.Response[v1.GetSessionStatusResponse], error)
+	// Get a player's multi-day campaign progress
+	GetCampaign(context.Context, *connect.Request[v1.GetCampaignRequest]) (*connect.Response[v1.GetCampaignResponse], error)
+	// Get the end-of-shift debrief with a per-case breakdown
+	GetShiftReport(context.Context, *connect.Request[v1.GetShiftReportRequest]) (*connect.Response[v1.GetShiftReportResponse], error)
+	// Resume a session after a reload or disconnect
+	ResumeSession(context.Context, *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error)
+	// List a player's sessions, newest first
+	ListMySessions(context.Context, *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error)
+	// Admin: list the voice catalog (requires the admin token)
+	ListVoices(context.Context, *connect.Request[v1.ListVoicesRequest]) (*connect.Response[v1.ListVoicesResponse], error)
+	// Admin: AI usage and estimated cost for the server, a session or a player
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
 }
 
 // NewGameServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -185,6 +390,24 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceMethods.ByName("StartSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceStartSessionGenerationHandler := connect.NewUnaryHandler(
+		GameServiceStartSessionGenerationProcedure,
+		svc.StartSessionGeneration,
+		connect.WithSchema(gameServiceMethods.ByName("StartSessionGeneration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceWatchSessionGenerationHandler := connect.NewServerStreamHandler(
+		GameServiceWatchSessionGenerationProcedure,
+		svc.WatchSessionGeneration,
+		connect.WithSchema(gameServiceMethods.ByName("WatchSessionGeneration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceCancelSessionGenerationHandler := connect.NewUnaryHandler(
+		GameServiceCancelSessionGenerationProcedure,
+		svc.CancelSessionGeneration,
+		connect.WithSchema(gameServiceMethods.ByName("CancelSessionGeneration")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceGetNextCaseHandler := connect.NewUnaryHandler(
 		GameServiceGetNextCaseProcedure,
 		svc.GetNextCase,
@@ -197,6 +420,18 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceMethods.ByName("AskQuestion")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceAskQuestionByVoiceHandler := connect.NewBidiStreamHandler(
+		GameServiceAskQuestionByVoiceProcedure,
+		svc.AskQuestionByVoice,
+		connect.WithSchema(gameServiceMethods.ByName("AskQuestionByVoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceInterrogateHandler := connect.NewBidiStreamHandler(
+		GameServiceInterrogateProcedure,
+		svc.Interrogate,
+		connect.WithSchema(gameServiceMethods.ByName("Interrogate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceSecondaryCheckHandler := connect.NewUnaryHandler(
 		GameServiceSecondaryCheckProcedure,
 		svc.SecondaryCheck,
@@ -215,20 +450,78 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceMethods.ByName("GetSessionStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceGetCampaignHandler := connect.NewUnaryHandler(
+		GameServiceGetCampaignProcedure,
+		svc.GetCampaign,
+		connect.WithSchema(gameServiceMethods.ByName("GetCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceGetShiftReportHandler := connect.NewUnaryHandler(
+		GameServiceGetShiftReportProcedure,
+		svc.GetShiftReport,
+		connect.WithSchema(gameServiceMethods.ByName("GetShiftReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceResumeSessionHandler := connect.NewUnaryHandler(
+		GameServiceResumeSessionProcedure,
+		svc.ResumeSession,
+		connect.WithSchema(gameServiceMethods.ByName("ResumeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceListMySessionsHandler := connect.NewUnaryHandler(
+		GameServiceListMySessionsProcedure,
+		svc.ListMySessions,
+		connect.WithSchema(gameServiceMethods.ByName("ListMySessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceListVoicesHandler := connect.NewUnaryHandler(
+		GameServiceListVoicesProcedure,
+		svc.ListVoices,
+		connect.WithSchema(gameServiceMethods.ByName("ListVoices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceGetUsageHandler := connect.NewUnaryHandler(
+		GameServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(gameServiceMethods.ByName("GetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/game.v1.GameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameServiceStartSessionProcedure:
 			gameServiceStartSessionHandler.ServeHTTP(w, r)
+		case GameServiceStartSessionGenerationProcedure:
+			gameServiceStartSessionGenerationHandler.ServeHTTP(w, r)
+		case GameServiceWatchSessionGenerationProcedure:
+			gameServiceWatchSessionGenerationHandler.ServeHTTP(w, r)
+		case GameServiceCancelSessionGenerationProcedure:
+			gameServiceCancelSessionGenerationHandler.ServeHTTP(w, r)
 		case GameServiceGetNextCaseProcedure:
 			gameServiceGetNextCaseHandler.ServeHTTP(w, r)
 		case GameServiceAskQuestionProcedure:
 			gameServiceAskQuestionHandler.ServeHTTP(w, r)
+		case GameServiceAskQuestionByVoiceProcedure:
+			gameServiceAskQuestionByVoiceHandler.ServeHTTP(w, r)
+		case GameServiceInterrogateProcedure:
+			gameServiceInterrogateHandler.ServeHTTP(w, r)
 		case GameServiceSecondaryCheckProcedure:
 			gameServiceSecondaryCheckHandler.ServeHTTP(w, r)
 		case GameServiceResolveCaseProcedure:
 			gameServiceResolveCaseHandler.ServeHTTP(w, r)
 		case GameServiceGetSessionStatusProcedure:
 			gameServiceGetSessionStatusHandler.ServeHTTP(w, r)
+		case GameServiceGetCampaignProcedure:
+			gameServiceGetCampaignHandler.ServeHTTP(w, r)
+		case GameServiceGetShiftReportProcedure:
+			gameServiceGetShiftReportHandler.ServeHTTP(w, r)
+		case GameServiceResumeSessionProcedure:
+			gameServiceResumeSessionHandler.ServeHTTP(w, r)
+		case GameServiceListMySessionsProcedure:
+			gameServiceListMySessionsHandler.ServeHTTP(w, r)
+		case GameServiceListVoicesProcedure:
+			gameServiceListVoicesHandler.ServeHTTP(w, r)
+		case GameServiceGetUsageProcedure:
+			gameServiceGetUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -242,6 +535,18 @@ func (UnimplementedGameServiceHandler) StartSession(context.Context, *connect.Re
 	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.StartSession is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) StartSessionGeneration(context.Context, *connect.Request[v1.StartSessionRequest]) (*connect.Response[v1.StartSessionGenerationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.StartSessionGeneration is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) WatchSessionGeneration(context.Context, *connect.Request[v1.WatchSessionGenerationRequest], *connect.ServerStream[v1.StartSessionResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.WatchSessionGeneration is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) CancelSessionGeneration(context.Context, *connect.Request[v1.CancelSessionGenerationRequest]) (*connect.Response[v1.CancelSessionGenerationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.CancelSessionGeneration is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) GetNextCase(context.Context, *connect.Request[v1.GetNextCaseRequest]) (*connect.Response[v1.GetNextCaseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetNextCase is not implemented"))
 }
@@ -250,6 +555,14 @@ func (UnimplementedGameServiceHandler) AskQuestion(context.Context, *connect.Req
 	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.AskQuestion is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) AskQuestionByVoice(context.Context, *connect.BidiStream[v1.VoiceQuestionRequest, v1.AskQuestionResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.AskQuestionByVoice is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) Interrogate(context.Context, *connect.BidiStream[v1.InterrogateRequest, v1.InterrogateResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.Interrogate is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) SecondaryCheck(context.Context, *connect.Request[v1.SecondaryCheckRequest]) (*connect.Response[v1.SecondaryCheckResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.SecondaryCheck is not implemented"))
 }
@@ -261,3 +574,27 @@ func (UnimplementedGameServiceHandler) ResolveCase(context.Context, *connect.Req
 func (UnimplementedGameServiceHandler) GetSessionStatus(context.Context, *connect.Request[v1.GetSessionStatusRequest]) (*connect.Response[v1.GetSessionStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetSessionStatus is not implemented"))
 }
+
+func (UnimplementedGameServiceHandler) GetCampaign(context.Context, *connect.Request[v1.GetCampaignRequest]) (*connect.Response[v1.GetCampaignResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetCampaign is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) GetShiftReport(context.Context, *connect.Request[v1.GetShiftReportRequest]) (*connect.Response[v1.GetShiftReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetShiftReport is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) ResumeSession(context.Context, *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.ResumeSession is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) ListMySessions(context.Context, *connect.Request[v1.ListMySessionsRequest]) (*connect.Response[v1.ListMySessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.ListMySessions is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) ListVoices(context.Context, *connect.Request[v1.ListVoicesRequest]) (*connect.Response[v1.ListVoicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.ListVoices is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetUsage is not implemented"))
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// Adaptive difficulty thresholds
+const (
+	adaptiveMinDecisions = 2    // Decisions needed before difficulty starts shifting
+	adaptiveEasyBelow    = 0.5  // Accuracy under this gets obvious violations
+	adaptiveHardAbove    = 0.85 // Accuracy at or above this gets subtle forgeries
+)
+
+// nextDifficulty picks the difficulty of the upcoming case from the player's accuracy so far
+func nextDifficulty(session *models.Session) models.Difficulty {
+	decided := session.CorrectDecisions + session.IncorrectDecisions
+	if decided < adaptiveMinDecisions {
+		return models.DifficultyNormal
+	}
+
+	accuracy := float64(session.CorrectDecisions) / float64(decided)
+	switch {
+	case accuracy < adaptiveEasyBelow:
+		return models.DifficultyEasy
+	case accuracy >= adaptiveHardAbove:
+		return models.DifficultyHard
+	default:
+		return models.DifficultyNormal
+	}
+}
+
+// caseDifficulty picks the difficulty of the next generated case
+func caseDifficulty(session *models.Session) models.Difficulty {
+	if session.Adaptive {
+		return nextDifficulty(session)
+	}
+	return models.DifficultyNormal
+}
+
+// generateNextCase generates the case after the session's last generated
+// case and stores it, returning the refreshed session
+func (h *GameHandler) generateNextCase(ctx context.Context, session *models.Session) (*models.Session, error) {
+	index := len(session.Cases)
+	difficulty := caseDifficulty(session)
+
+	// Reuse a pooled case when there is one
+	cases := h.takePooledCases(ctx, session.PoolKey, session.GameDate, difficulty, 1, session.Cases)
+	renumberCases(cases, index)
+	pooled := len(cases) > 0
+
+	if !pooled {
+		var err error
+		cases, err = h.content.GenerateCases(ctx, models.CaseRequest{
+			Rules:      session.Rules,
+			GameDate:   session.GameDate,
+			Count:      1,
+			StartIndex: index,
+			Difficulty: difficulty,
+			Storyline:  session.Storyline,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate case: %w", err)
+		}
+		if len(cases) == 0 {
+			return nil, fmt.Errorf("no case generated")
+		}
+	}
+
+	if err := h.attachRoster(ctx, session.PlayerID, cases, nil); err != nil {
+		return nil, err
+	}
+
+	caseData := cases[0]
+	if len(caseData.OpeningAudio) == 0 {
+		h.generateOpeningAudio(ctx, &caseData)
+	}
+	if !pooled {
+		h.contributeToPool(ctx, session, difficulty, cases[:1])
+	}
+
+	appended, err := h.firestore.AppendCase(ctx, session.SessionID, index, caseData)
+	if err != nil {
+		return nil, err
+	}
+	if appended {
+		log.Printf("Session %s: generated %s at %s difficulty", session.SessionID, caseData.CaseID, difficulty)
+	}
+
+	// Re-read the session; a concurrent request may have filled the slot first
+	return h.firestore.GetSession(ctx, session.SessionID)
+}
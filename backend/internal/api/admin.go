@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	gamev1 "github.com/ttrubel/send-me-home/gen/game/v1"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/usage"
+)
+
+// requireAdmin checks the bearer token of an admin RPC
+func (h *GameHandler) requireAdmin(header http.Header) error {
+	if h.cfg.AdminToken == "" {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("admin RPCs are disabled"))
+	}
+
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.cfg.AdminToken)) != 1 {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid admin token"))
+	}
+
+	return nil
+}
+
+// ListVoices returns the voice catalog
+func (h *GameHandler) ListVoices(
+	ctx context.Context,
+	req *connect.Request[gamev1.ListVoicesRequest],
+) (*connect.Response[gamev1.ListVoicesResponse], error) {
+	if err := h.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	var voices []*gamev1.VoiceInfo
+	for _, v := range h.voices.Voices() {
+		if !v.Enabled && !req.Msg.IncludeDisabled {
+			continue
+		}
+
+		emotions := make([]string, 0, len(v.Emotions))
+		for emotion := range v.Emotions {
+			emotions = append(emotions, emotion)
+		}
+		slices.Sort(emotions)
+
+		voices = append(voices, &gamev1.VoiceInfo{
+			Id:               v.ID,
+			Name:             v.Name,
+			Description:      v.Description,
+			Enabled:          v.Enabled,
+			VocalAge:         v.VocalAge,
+			Pitch:            v.Pitch,
+			Timbres:          v.Timbres,
+			Accent:           v.Accent,
+			Energy:           v.Energy,
+			Languages:        v.Languages,
+			EmotionOverrides: emotions,
+		})
+	}
+
+	return connect.NewResponse(&gamev1.ListVoicesResponse{Voices: voices}), nil
+}
+
+// GetUsage returns AI usage and its estimated cost for the server and,
+// if asked, for a session and a player
+func (h *GameHandler) GetUsage(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetUsageRequest],
+) (*connect.Response[gamev1.GetUsageResponse], error) {
+	if err := h.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	prices := usage.ConfiguredPrices(h.cfg)
+	response := &gamev1.GetUsageResponse{
+		Server: toProtoUsage(usage.Totals(), prices),
+	}
+
+	// Include what is still waiting to be written
+	h.usage.Flush(ctx)
+
+	if req.Msg.SessionId != "" {
+		sessionUsage, err := h.firestore.GetSessionUsage(ctx, req.Msg.SessionId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		response.Session = toProtoUsage(sessionUsage, prices)
+	}
+
+	if req.Msg.PlayerId != "" {
+		playerUsage, err := h.firestore.GetPlayerUsage(ctx, req.Msg.PlayerId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		response.Player = toProtoUsage(playerUsage, prices)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// toProtoUsage converts usage to its protobuf form with an estimated cost
+func toProtoUsage(u models.Usage, prices usage.Prices) *gamev1.UsageTotals {
+	return &gamev1.UsageTotals{
+		LlmCalls:         u.LLMCalls,
+		PromptTokens:     u.PromptTokens,
+		OutputTokens:     u.OutputTokens,
+		TtsCalls:         u.TTSCalls,
+		TtsCharacters:    u.TTSCharacters,
+		EstimatedCostUsd: prices.Cost(u),
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"log"
+
+	gamev1 "github.com/ttrubel/send-me-home/gen/game/v1"
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+)
+
+// audioFormats maps the codecs clients can ask for to synthesis formats
+var audioFormats = map[gamev1.AudioFormat]tts.Format{
+	gamev1.AudioFormat_AUDIO_FORMAT_MP3_64:    tts.FormatMP3Low,
+	gamev1.AudioFormat_AUDIO_FORMAT_MP3_128:   tts.FormatMP3,
+	gamev1.AudioFormat_AUDIO_FORMAT_MP3_192:   tts.FormatMP3High,
+	gamev1.AudioFormat_AUDIO_FORMAT_OPUS:      tts.FormatOpus,
+	gamev1.AudioFormat_AUDIO_FORMAT_PCM_16000: tts.FormatPCM16000,
+	gamev1.AudioFormat_AUDIO_FORMAT_PCM_24000: tts.FormatPCM24000,
+}
+
+// audioFormat returns the synthesis format for a client's preference, or
+// an empty format if it has none
+func audioFormat(format gamev1.AudioFormat) tts.Format {
+	return audioFormats[format]
+}
+
+// audioMimeType returns the MIME type to send with audio, or "" if there is no audio
+func audioMimeType(audio []byte, format tts.Format) string {
+	if len(audio) == 0 {
+		return ""
+	}
+	return format.MimeType()
+}
+
+// revoiceOpening voices the opening line again if the client wants its
+// audio in another format than the pre-generated one. The new audio is only
+// for this response; the stored case keeps the original.
+func (h *GameHandler) revoiceOpening(ctx context.Context, caseData *models.Case, format tts.Format) {
+	stored := tts.Format(caseData.OpeningAudioFormat)
+	if stored == "" {
+		stored = tts.DefaultFormat
+	}
+	if format == "" || format == stored || len(caseData.OpeningAudio) == 0 {
+		return
+	}
+
+	speech, err := h.speech.SynthesizeTimed(lexicon.WithPronunciations(ctx, caseData.NPC.Pronunciations), caseData.NPC.VoiceID, caseData.OpeningLine, tts.EmotionNeutral, format)
+	if err != nil {
+		log.Printf("Warning: Failed to voice %s as %s: %v", caseData.CaseID, format, err)
+		return
+	}
+	if speech == nil {
+		return
+	}
+
+	caseData.OpeningAudio = speech.Audio
+	caseData.OpeningAudioFormat = string(speech.Format)
+	caseData.OpeningSubtitles = speech.Words
+}
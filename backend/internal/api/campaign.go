@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/services/firestore"
+)
+
+// loadCampaign returns the player's campaign, starting a new one if needed
+func (h *GameHandler) loadCampaign(ctx context.Context, playerID string) (*models.Campaign, error) {
+	campaign, err := h.firestore.GetCampaign(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign == nil {
+		campaign = &models.Campaign{PlayerID: playerID}
+	}
+	return campaign, nil
+}
+
+// nextCampaignDate returns the game date of the campaign's next day. The
+// first day starts on startDate, like standalone shifts.
+func nextCampaignDate(campaign *models.Campaign, startDate string) string {
+	if campaign.Day == 0 || campaign.GameDate == "" {
+		return startDate
+	}
+
+	lastDate, err := time.Parse("2006-01-02", campaign.GameDate)
+	if err != nil {
+		return startDate
+	}
+	return lastDate.AddDate(0, 0, 1).Format("2006-01-02")
+}
+
+// saveCampaignDay saves the session and advances the campaign to its day.
+// It fails if another session started the day first.
+func (h *GameHandler) saveCampaignDay(ctx context.Context, campaign *models.Campaign, session *models.Session, storyEvent string) error {
+	fromDay := campaign.Day
+	campaign.Day = session.CampaignDay
+	campaign.GameDate = session.GameDate
+	campaign.Rules = session.Rules
+	campaign.Days = append(campaign.Days, models.CampaignDay{
+		Day:        session.CampaignDay,
+		GameDate:   session.GameDate,
+		Rules:      session.Rules,
+		StoryEvent: storyEvent,
+		SessionID:  session.SessionID,
+	})
+
+	if err := h.firestore.StartCampaignDay(ctx, campaign, fromDay, session); err != nil {
+		if errors.Is(err, firestore.ErrCampaignMoved) {
+			return fmt.Errorf("day %d of the campaign was started by another session, start the shift again", session.CampaignDay)
+		}
+		return err
+	}
+
+	log.Printf("Campaign %s advanced to day %d (%s)", campaign.PlayerID, campaign.Day, campaign.GameDate)
+	return nil
+}
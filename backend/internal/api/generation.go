@@ -0,0 +1,249 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	gamev1 "github.com/ttrubel/send-me-home/gen/game/v1"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/services/firestore"
+)
+
+const (
+	// defaultNumCases is used when StartSession doesn't ask for a count
+	defaultNumCases = 15
+
+	// generationTimeout bounds a background generation job
+	generationTimeout = 5 * time.Minute
+)
+
+// generationJobs tracks jobs running on this instance so they can be
+// cancelled immediately. Jobs on other instances notice cancellation the
+// next time they report progress.
+type generationJobs struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func newGenerationJobs() *generationJobs {
+	return &generationJobs{cancels: make(map[string]context.CancelFunc)}
+}
+
+func (j *generationJobs) add(jobID string, cancel context.CancelFunc) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.cancels[jobID] = cancel
+}
+
+func (j *generationJobs) remove(jobID string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.cancels, jobID)
+}
+
+func (j *generationJobs) cancel(jobID string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if cancel, ok := j.cancels[jobID]; ok {
+		cancel()
+	}
+}
+
+// StartSessionGeneration starts generating a session in the background
+func (h *GameHandler) StartSessionGeneration(
+	ctx context.Context,
+	req *connect.Request[gamev1.StartSessionRequest],
+) (*connect.Response[gamev1.StartSessionGenerationResponse], error) {
+	job, err := h.startGenerationJob(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&gamev1.StartSessionGenerationResponse{JobId: job.JobID}), nil
+}
+
+// WatchSessionGeneration streams a job's progress until the session is ready
+func (h *GameHandler) WatchSessionGeneration(
+	ctx context.Context,
+	req *connect.Request[gamev1.WatchSessionGenerationRequest],
+	stream *connect.ServerStream[gamev1.StartSessionResponse],
+) error {
+	if _, err := h.firestore.GetJob(ctx, req.Msg.JobId); err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return h.watchGeneration(ctx, req.Msg.JobId, stream)
+}
+
+// CancelSessionGeneration stops a running generation job
+func (h *GameHandler) CancelSessionGeneration(
+	ctx context.Context,
+	req *connect.Request[gamev1.CancelSessionGenerationRequest],
+) (*connect.Response[gamev1.CancelSessionGenerationResponse], error) {
+	if _, err := h.firestore.GetJob(ctx, req.Msg.JobId); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	final, err := h.firestore.FinishJob(ctx, req.Msg.JobId, models.JobCancelled, "", "")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.jobs.cancel(req.Msg.JobId)
+
+	return connect.NewResponse(&gamev1.CancelSessionGenerationResponse{
+		Cancelled: final == models.JobCancelled,
+	}), nil
+}
+
+// startGenerationJob persists a new job and starts generating in the background
+func (h *GameHandler) startGenerationJob(ctx context.Context, req *gamev1.StartSessionRequest) (*models.GenerationJob, error) {
+	numCases := int(req.NumCases)
+	if numCases <= 0 {
+		numCases = defaultNumCases
+	}
+
+	if req.Campaign && req.PlayerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("player_id is required for campaign shifts"))
+	}
+
+	if err := h.budget.AdmitSession(ctx, req.PlayerId); err != nil {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
+
+	now := time.Now()
+	job := &models.GenerationJob{
+		JobID:     uuid.New().String(),
+		PlayerID:  req.PlayerId,
+		NumCases:  numCases,
+		Adaptive:  req.Adaptive,
+		Campaign:  req.Campaign,
+		Pipeline:  req.Pipeline,
+		Status:    models.JobRunning,
+		Total:     numCases,
+		Message:   "Initializing...",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	// The job outlives the request that started it
+	ctx, cancel := context.WithTimeout(context.Background(), generationTimeout)
+	if err := h.firestore.SaveJob(ctx, job); err != nil {
+		cancel()
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	h.jobs.add(job.JobID, cancel)
+	go h.runGenerationJob(ctx, cancel, job)
+
+	return job, nil
+}
+
+// runGenerationJob generates the session and records how the job ended
+func (h *GameHandler) runGenerationJob(ctx context.Context, cancel context.CancelFunc, job *models.GenerationJob) {
+	defer h.jobs.remove(job.JobID)
+	defer cancel()
+
+	session, err := h.generateSession(ctx, job)
+
+	// Record the outcome even if the job context is done
+	finishCtx, finishCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer finishCancel()
+
+	switch {
+	case err == nil:
+		_, err = h.firestore.FinishJob(finishCtx, job.JobID, models.JobReady, session.SessionID, "")
+	case errors.Is(err, firestore.ErrJobCancelled) || errors.Is(err, context.Canceled):
+		log.Printf("Generation job %s cancelled", job.JobID)
+		_, err = h.firestore.FinishJob(finishCtx, job.JobID, models.JobCancelled, "", "")
+	default:
+		log.Printf("Generation job %s failed: %v", job.JobID, err)
+		_, err = h.firestore.FinishJob(finishCtx, job.JobID, models.JobFailed, "", err.Error())
+	}
+	if err != nil {
+		log.Printf("Warning: Failed to finish generation job %s: %v", job.JobID, err)
+	}
+}
+
+// reportProgress persists a job's progress, failing once the job is cancelled
+func (h *GameHandler) reportProgress(ctx context.Context, job *models.GenerationJob, current, total int, message string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return h.firestore.UpdateJobProgress(ctx, job.JobID, current, total, message)
+}
+
+// watchGeneration streams a job's persisted progress and the ready signal
+func (h *GameHandler) watchGeneration(ctx context.Context, jobID string, stream *connect.ServerStream[gamev1.StartSessionResponse]) error {
+	var finished *models.GenerationJob
+	var sendErr error
+
+	err := h.firestore.WatchJob(ctx, jobID, func(job *models.GenerationJob) bool {
+		if job.Finished() {
+			finished = job
+			return false
+		}
+
+		// A job that stopped reporting died with its instance
+		if time.Since(job.UpdatedAt) > generationTimeout {
+			job.Status = models.JobFailed
+			job.Error = "generation stalled"
+			finished = job
+			return false
+		}
+
+		sendErr = stream.Send(&gamev1.StartSessionResponse{
+			Update: &gamev1.StartSessionResponse_Progress{
+				Progress: &gamev1.SessionProgress{
+					Current: int32(job.Current),
+					Total:   int32(job.Total),
+					Message: job.Message,
+					JobId:   job.JobID,
+				},
+			},
+		})
+		return sendErr == nil
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if ctx.Err() != nil {
+		return connect.NewError(connect.CodeCanceled, ctx.Err())
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	switch finished.Status {
+	case models.JobCancelled:
+		return connect.NewError(connect.CodeCanceled, fmt.Errorf("session generation was cancelled"))
+	case models.JobFailed:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("session generation failed: %s", finished.Error))
+	}
+
+	session, err := h.firestore.GetSession(ctx, finished.SessionID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return stream.Send(&gamev1.StartSessionResponse{
+		Update: &gamev1.StartSessionResponse_Ready{
+			Ready: &gamev1.SessionReady{
+				SessionId:            session.SessionID,
+				GameDate:             session.GameDate,
+				Rules:                session.Rules,
+				TotalCases:           int32(session.CaseCount()),
+				SecondaryChecksQuota: int32(session.SecondaryChecksQuota),
+				CampaignDay:          int32(session.CampaignDay),
+				StoryEvent:           session.StoryEvent(),
+				ShiftLengthSeconds:   int32(session.ShiftLength.Seconds()),
+			},
+		},
+	})
+}
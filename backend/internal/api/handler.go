@@ -4,105 +4,232 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 
 	gamev1 "github.com/ttrubel/send-me-home/gen/game/v1"
+	"github.com/ttrubel/send-me-home/internal/budget"
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/lexicon"
 	"github.com/ttrubel/send-me-home/internal/models"
-	"github.com/ttrubel/send-me-home/internal/services/elevenlabs"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/scoring"
 	"github.com/ttrubel/send-me-home/internal/services/firestore"
-	"github.com/ttrubel/send-me-home/internal/services/gemini"
+	"github.com/ttrubel/send-me-home/internal/services/llm"
+	"github.com/ttrubel/send-me-home/internal/services/stt"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+	"github.com/ttrubel/send-me-home/internal/shift"
+	"github.com/ttrubel/send-me-home/internal/usage"
+	"github.com/ttrubel/send-me-home/internal/voices"
 )
 
 type GameHandler struct {
-	gemini     *gemini.Client
-	firestore  *firestore.Client
-	elevenlabs *elevenlabs.Client
+	cfg       *config.Config
+	content   llm.ContentGenerator
+	firestore *firestore.Client
+	speech    tts.SpeechSynthesizer
+	stt       stt.Transcriber
+	voices    *voices.Registry
+	scorer    *scoring.Engine
+	usage     *usage.Ledger
+	budget    *budget.Budget
+	jobs      *generationJobs
+	pipelines *pipelineFillers
 }
 
-func NewGameHandler(geminiClient *gemini.Client, firestoreClient *firestore.Client, elevenlabsClient *elevenlabs.Client) *GameHandler {
+func NewGameHandler(cfg *config.Config, content llm.ContentGenerator, firestoreClient *firestore.Client, speech tts.SpeechSynthesizer, transcriber stt.Transcriber, voices *voices.Registry, scorer *scoring.Engine, ledger *usage.Ledger, spending *budget.Budget) *GameHandler {
 	return &GameHandler{
-		gemini:     geminiClient,
-		firestore:  firestoreClient,
-		elevenlabs: elevenlabsClient,
+		cfg:       cfg,
+		content:   content,
+		firestore: firestoreClient,
+		speech:    speech,
+		stt:       transcriber,
+		voices:    voices,
+		scorer:    scorer,
+		usage:     ledger,
+		budget:    spending,
+		jobs:      newGenerationJobs(),
+		pipelines: newPipelineFillers(),
 	}
 }
 
-// StartSession generates all cases upfront and returns progress updates
+// startDate returns the game date of a new shift: 100 years from now, or a
+// fixed date in mock mode, where content mustn't move with the clock
+func (h *GameHandler) startDate() string {
+	if h.cfg.ContentMode == "mock" {
+		return llm.MockGameDate
+	}
+	return time.Now().AddDate(100, 0, 0).Format("2006-01-02")
+}
+
+// draws returns the random source for choices made around generated
+// content, such as returning workers and pooled cases. In mock mode it is
+// derived from the seed and parts, so the same inputs give the same session.
+func (h *GameHandler) draws(parts ...string) *rand.Rand {
+	if h.cfg.ContentMode == "mock" {
+		return llm.SeededRand(uint64(h.cfg.MockSeed), parts...)
+	}
+	return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+}
+
+// StartSession generates all cases upfront and returns progress updates.
+// Generation runs as a background job, so a dropped stream can be picked
+// up again with WatchSessionGeneration.
 func (h *GameHandler) StartSession(
 	ctx context.Context,
 	req *connect.Request[gamev1.StartSessionRequest],
 	stream *connect.ServerStream[gamev1.StartSessionResponse],
 ) error {
-	numCases := int(req.Msg.NumCases)
-	if numCases <= 0 {
-		numCases = 15
+	job, err := h.startGenerationJob(ctx, req.Msg)
+	if err != nil {
+		return err
 	}
 
-	// Set game date to current date + 100 years
-	gameDate := time.Now().AddDate(100, 0, 0).Format("2006-01-02")
+	return h.watchGeneration(ctx, job.JobID, stream)
+}
 
-	// Step 1: Generate rules
-	stream.Send(&gamev1.StartSessionResponse{
-		Update: &gamev1.StartSessionResponse_Progress{
-			Progress: &gamev1.SessionProgress{
-				Current: 0,
-				Total:   int32(numCases),
-				Message: "Generating daily rules...",
-			},
-		},
-	})
+// generateSession generates rules, cases and audio for a job and saves the session
+func (h *GameHandler) generateSession(ctx context.Context, job *models.GenerationJob) (*models.Session, error) {
+	numCases := job.NumCases
+
+	// Pick the ID upfront so generation counts toward the session's usage
+	sessionID := uuid.New().String()
+	ctx = h.usage.WithSession(ctx, sessionID)
+	h.budget.TrackSession(sessionID, job.PlayerID)
+
+	gameDate := h.startDate()
+
+	// Campaign shifts continue from the player's previous day
+	var campaign *models.Campaign
+	if job.Campaign {
+		var err error
+		campaign, err = h.loadCampaign(ctx, job.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		gameDate = nextCampaignDate(campaign, gameDate)
+	}
+
+	// Adaptive sessions only generate the first case up front; the rest are
+	// generated on demand in GetNextCase to match the player's accuracy
+	upfrontCases := numCases
+	if job.Adaptive {
+		upfrontCases = 1
+	}
+	// Pipelined sessions start with a few cases and generate the rest in
+	// the background ahead of the player
+	if job.Pipeline && !job.Adaptive {
+		upfrontCases = min(pipelineUpfront, numCases)
+	}
+
+	// Step 1: Pick rules - standalone shifts reuse a pooled rule set when
+	// the case pool can cover the session
+	var poolRuleSet *models.PoolRuleSet
+	if campaign == nil {
+		poolRuleSet = h.pickPoolRuleSet(ctx, models.DifficultyNormal, upfrontCases)
+	}
 
-	rules, err := h.gemini.GenerateRules(ctx, gameDate)
+	var rules []string
+	var err error
+	if poolRuleSet != nil {
+		days := models.DaysBetween(poolRuleSet.GameDate, gameDate)
+		for _, rule := range poolRuleSet.Rules {
+			rules = append(rules, models.ShiftDatesIn(rule, days))
+		}
+	} else {
+		if err := h.reportProgress(ctx, job, 0, numCases, "Generating daily rules..."); err != nil {
+			return nil, err
+		}
+
+		var previousRules []string
+		if campaign != nil {
+			previousRules = campaign.Rules
+		}
+
+		rules, err = h.content.GenerateRules(ctx, gameDate, previousRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate rules: %w", err)
+		}
+	}
+
+	// Standalone shifts draw from and add to the case pool
+	poolKey := ""
+	if poolRuleSet != nil {
+		poolKey = poolRuleSet.Key
+	} else if campaign == nil && h.cfg.CasePool {
+		poolKey = models.RuleSetKey(rules)
+	}
+
+	// Step 1.5: Continue the campaign storyline
+	storyEvent := ""
+	var storyline []string
+	if campaign != nil {
+		storyEvent, err = h.content.GenerateStoryEvent(ctx, campaign.Day+1, gameDate, campaign.Storyline(), rules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate story event: %w", err)
+		}
+		storyline = append(campaign.Storyline(), storyEvent)
+	}
+
+	// Bring back workers the player has met before
+	returningNPCs, err := h.pickReturningNPCs(ctx, job.PlayerID, gameDate)
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate rules: %w", err))
+		log.Printf("Warning: Failed to load NPC roster: %v", err)
+		returningNPCs = nil
+	}
+	if len(returningNPCs) > upfrontCases {
+		returningNPCs = returningNPCs[:upfrontCases]
 	}
 
-	// Step 2: Generate cases
-	for i := 0; i < numCases; i++ {
-		stream.Send(&gamev1.StartSessionResponse{
-			Update: &gamev1.StartSessionResponse_Progress{
-				Progress: &gamev1.SessionProgress{
-					Current: int32(i),
-					Total:   int32(numCases),
-					Message: fmt.Sprintf("Generating case %d/%d...", i+1, numCases),
-				},
-			},
+	// Step 2: Take what the case pool has for these rules and generate the rest
+	pooledCases := h.takePooledCases(ctx, poolKey, gameDate, models.DifficultyNormal, upfrontCases-len(returningNPCs), nil)
+
+	var cases []models.Case
+	if generateCount := upfrontCases - len(pooledCases); generateCount > 0 {
+		if err := h.reportProgress(ctx, job, 0, numCases, fmt.Sprintf("Generating %d cases...", generateCount)); err != nil {
+			return nil, err
+		}
+
+		cases, err = h.content.GenerateCases(ctx, models.CaseRequest{
+			Rules:      rules,
+			GameDate:   gameDate,
+			Count:      generateCount,
+			Difficulty: models.DifficultyNormal,
+
+			Storyline:     storyline,
+			ReturningNPCs: returningNPCs,
 		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate cases: %w", err)
+		}
 	}
 
-	cases, err := h.gemini.GenerateCases(ctx, rules, numCases, gameDate)
-	if err != nil {
-		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate cases: %w", err))
+	generatedCases := len(cases)
+	renumberCases(pooledCases, generatedCases)
+	cases = append(cases, pooledCases...)
+
+	if err := h.attachRoster(ctx, job.PlayerID, cases, returningNPCs); err != nil {
+		return nil, err
 	}
 
 	// Step 2.5: Generate opening audio for each case with ElevenLabs
 	totalCases := len(cases)
 	for i := range cases {
-		stream.Send(&gamev1.StartSessionResponse{
-			Update: &gamev1.StartSessionResponse_Progress{
-				Progress: &gamev1.SessionProgress{
-					Current: int32(i),
-					Total:   int32(totalCases),
-					Message: fmt.Sprintf("Generating voice audio %d/%d...", i+1, totalCases),
-				},
-			},
-		})
+		if len(cases[i].OpeningAudio) > 0 {
+			continue // Pooled cases come with audio
+		}
 
-		// Generate audio for opening line
-		audioData, err := h.elevenlabs.TextToSpeech(ctx, cases[i].NPC.VoiceID, cases[i].OpeningLine)
-		if err != nil {
-			log.Printf("Warning: Failed to generate audio for case %d: %v", i, err)
-			// Continue without audio - it's optional
-		} else if audioData != nil {
-			cases[i].OpeningAudio = audioData
+		if err := h.reportProgress(ctx, job, i, totalCases, fmt.Sprintf("Generating voice audio %d/%d...", i+1, totalCases)); err != nil {
+			return nil, err
 		}
+
+		h.generateOpeningAudio(ctx, &cases[i])
 	}
 
 	// Step 3: Create session
-	sessionID := uuid.New().String()
 	secondaryChecksQuota := 3
 
 	session := &models.Session{
@@ -110,6 +237,12 @@ func (h *GameHandler) StartSession(
 		GameDate:                 gameDate,
 		Rules:                    rules,
 		Cases:                    cases,
+		TotalCases:               numCases,
+		Adaptive:                 job.Adaptive,
+		Pipeline:                 job.Pipeline,
+		PoolKey:                  poolKey,
+		PlayerID:                 job.PlayerID,
+		Storyline:                storyline,
 		CurrentCaseIndex:         0,
 		Score:                    0,
 		CorrectDecisions:         0,
@@ -117,27 +250,50 @@ func (h *GameHandler) StartSession(
 		SecondaryChecksQuota:     secondaryChecksQuota,
 		RemainingSecondaryChecks: secondaryChecksQuota,
 		CompletedCases:           []string{},
+		Resolutions:              []models.Resolution{},
+		ShiftStartedAt:           time.Now(),
+		ShiftLength:              h.cfg.ShiftLength,
 	}
 
-	if err := h.firestore.SaveSession(ctx, session); err != nil {
-		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save session: %w", err))
+	if campaign != nil {
+		session.CampaignDay = campaign.Day + 1
 	}
 
-	// Step 4: Send ready signal
-	stream.Send(&gamev1.StartSessionResponse{
-		Update: &gamev1.StartSessionResponse_Ready{
-			Ready: &gamev1.SessionReady{
-				SessionId:            sessionID,
-				GameDate:             gameDate,
-				Rules:                rules,
-				TotalCases:           int32(numCases),
-				SecondaryChecksQuota: int32(secondaryChecksQuota),
-			},
-		},
-	})
+	// Don't save a session nobody is waiting for
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if campaign != nil {
+		if err := h.saveCampaignDay(ctx, campaign, session, storyEvent); err != nil {
+			return nil, err
+		}
+	} else if err := h.firestore.SaveSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save session: %w", err)
+	}
+
+	// Let later sessions with the same rules reuse the new cases
+	h.contributeToPool(ctx, session, models.DifficultyNormal, cases[:generatedCases])
+
+	if session.Pipeline {
+		h.fillPipeline(sessionID)
+	}
 
 	log.Printf("Session started: %s with %d cases", sessionID, numCases)
-	return nil
+	return session, nil
+}
+
+// generateOpeningAudio voices the opening line of a case
+func (h *GameHandler) generateOpeningAudio(ctx context.Context, caseData *models.Case) {
+	speech, err := h.speech.SynthesizeTimed(lexicon.WithPronunciations(ctx, caseData.NPC.Pronunciations), caseData.NPC.VoiceID, caseData.OpeningLine, tts.EmotionNeutral, tts.DefaultFormat)
+	if err != nil {
+		log.Printf("Warning: Failed to generate audio for %s: %v", caseData.CaseID, err)
+		// Continue without audio - it's optional
+	} else if speech != nil {
+		caseData.OpeningAudio = speech.Audio
+		caseData.OpeningAudioFormat = string(speech.Format)
+		caseData.OpeningSubtitles = speech.Words
+	}
 }
 
 // GetNextCase returns the next pre-generated case
@@ -145,17 +301,58 @@ func (h *GameHandler) GetNextCase(
 	ctx context.Context,
 	req *connect.Request[gamev1.GetNextCaseRequest],
 ) (*connect.Response[gamev1.GetNextCaseResponse], error) {
+	ctx = h.usage.WithSession(ctx, req.Msg.SessionId)
+
 	session, err := h.firestore.GetSession(ctx, req.Msg.SessionId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	if session.CurrentCaseIndex >= len(session.Cases) {
+	if session.CurrentCaseIndex >= session.CaseCount() {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no more cases available"))
 	}
 
+	clock := shift.NewClock(session.ClockStartedAt(), session.ShiftLength)
+	if clock.Departed(time.Now()) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the shuttle has departed"))
+	}
+
+	// Pipelined sessions generate ahead in the background; wait for the case
+	if session.Pipeline {
+		h.fillPipeline(session.SessionID)
+		if session.CurrentCaseIndex >= len(session.Cases) {
+			session, err = h.waitForCase(ctx, session.SessionID, session.CurrentCaseIndex)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Adaptive sessions generate the upcoming case on demand
+	if session.CurrentCaseIndex >= len(session.Cases) {
+		session, err = h.generateNextCase(ctx, session)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
 	currentCase := session.Cases[session.CurrentCaseIndex]
+	h.revoiceOpening(ctx, &currentCase, audioFormat(req.Msg.AudioFormat))
+
+	// Start the decision timer the first time the case is served; serving
+	// the first case also starts the shift clock
+	servedAt, err := h.firestore.MarkCaseServed(ctx, session.SessionID, currentCase.CaseID, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	session.Cases[session.CurrentCaseIndex].ServedAt = servedAt
+	clock = shift.NewClock(session.ClockStartedAt(), session.ShiftLength)
+
+	return connect.NewResponse(h.caseResponse(session, &currentCase, clock, time.Now())), nil
+}
 
+// caseResponse converts the session's current case to its protobuf form
+func (h *GameHandler) caseResponse(session *models.Session, currentCase *models.Case, clock shift.Clock, now time.Time) *gamev1.GetNextCaseResponse {
 	// Convert models.Document to protobuf Document
 	docs := make([]*gamev1.Document, len(currentCase.Documents))
 	for i, doc := range currentCase.Documents {
@@ -166,7 +363,7 @@ func (h *GameHandler) GetNextCase(
 		}
 	}
 
-	response := &gamev1.GetNextCaseResponse{
+	return &gamev1.GetNextCaseResponse{
 		CaseId: currentCase.CaseID,
 		Npc: &gamev1.NPCProfile{
 			Name:        currentCase.NPC.Name,
@@ -175,15 +372,20 @@ func (h *GameHandler) GetNextCase(
 			Personality: currentCase.NPC.Personality,
 			PortraitUrl: currentCase.NPC.PortraitURL,
 			Demeanor:    currentCase.NPC.Demeanor,
+
+			NpcId:              currentCase.NPC.NPCID,
+			PreviousEncounters: int32(currentCase.NPC.PreviousEncounters),
 		},
 		Documents:                docs,
 		OpeningLine:              currentCase.OpeningLine,
 		OpeningAudio:             currentCase.OpeningAudio,
+		OpeningSubtitles:         toProtoSubtitles(currentCase.OpeningSubtitles),
+		OpeningAudioMimeType:     audioMimeType(currentCase.OpeningAudio, tts.Format(currentCase.OpeningAudioFormat)),
 		CaseNumber:               int32(session.CurrentCaseIndex + 1),
 		RemainingSecondaryChecks: int32(session.RemainingSecondaryChecks),
+		RemainingShiftSeconds:    int32(clock.Remaining(now).Seconds()),
+		Degraded:                 currentCase.Mock && h.cfg.ContentMode != "mock",
 	}
-
-	return connect.NewResponse(response), nil
 }
 
 // AskQuestion generates NPC response to player question
@@ -192,49 +394,78 @@ func (h *GameHandler) AskQuestion(
 	req *connect.Request[gamev1.AskQuestionRequest],
 	stream *connect.ServerStream[gamev1.AskQuestionResponse],
 ) error {
+	ctx = h.usage.WithSession(ctx, req.Msg.SessionId)
+
 	caseData, err := h.firestore.GetCase(ctx, req.Msg.SessionId, req.Msg.CaseId)
 	if err != nil {
 		return connect.NewError(connect.CodeNotFound, err)
 	}
 
+	return h.answerQuestion(ctx, req.Msg.SessionId, caseData, req.Msg.Question, audioFormat(req.Msg.AudioFormat), stream.Send)
+}
+
+// answerQuestion generates the NPC's answer and sends it as text, audio and done chunks
+func (h *GameHandler) answerQuestion(ctx context.Context, sessionID string, caseData *models.Case, question string, format tts.Format, send func(*gamev1.AskQuestionResponse) error) error {
+	ctx, fellBack := resilience.TrackFallbacks(ctx)
+
 	// Generate dialogue with Gemini
 	dialogueCtx := models.DialogueContext{
-		Question:   req.Msg.Question,
+		Question:   question,
 		CaseTruth:  caseData.Truth,
 		NPCProfile: caseData.NPC,
 	}
 
-	responseText, err := h.gemini.GenerateDialogue(ctx, dialogueCtx)
+	reply, err := h.content.GenerateDialogue(ctx, dialogueCtx)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, err)
 	}
+	responseText := reply.Text
+
+	// Keep the exchange for resuming the session and the shift report
+	line := models.DialogueLine{Question: question, Answer: responseText, Emotion: reply.Emotion, AskedAt: time.Now()}
+	if err := h.firestore.RecordDialogue(ctx, sessionID, caseData.CaseID, line); err != nil {
+		log.Printf("Warning: Failed to record dialogue: %v", err)
+	}
 
 	// Send text chunk
-	stream.Send(&gamev1.AskQuestionResponse{
+	send(&gamev1.AskQuestionResponse{
 		Chunk: &gamev1.AskQuestionResponse_TextChunk{
 			TextChunk: responseText,
 		},
+		Degraded: fellBack(),
 	})
 
-	// Generate and stream audio with ElevenLabs
-	audioData, err := h.elevenlabs.TextToSpeech(ctx, caseData.NPC.VoiceID, responseText)
+	// Generate and stream audio
+	speech, err := h.speech.SynthesizeTimed(lexicon.WithPronunciations(ctx, caseData.NPC.Pronunciations), caseData.NPC.VoiceID, responseText, tts.ParseEmotion(reply.Emotion), format)
 	if err != nil {
 		log.Printf("Warning: Failed to generate audio for response: %v", err)
+		resilience.Fallback(ctx)
 		// Continue without audio - it's optional
-	} else if audioData != nil {
+	} else if speech != nil {
 		// Send audio chunk
-		stream.Send(&gamev1.AskQuestionResponse{
+		send(&gamev1.AskQuestionResponse{
 			Chunk: &gamev1.AskQuestionResponse_AudioChunk{
-				AudioChunk: audioData,
+				AudioChunk: speech.Audio,
 			},
+			AudioMimeType: speech.Format.MimeType(),
 		})
+
+		// Send word timings for the audio just sent
+		if len(speech.Words) > 0 {
+			send(&gamev1.AskQuestionResponse{
+				Chunk: &gamev1.AskQuestionResponse_Subtitles{
+					Subtitles: toProtoSubtitles(speech.Words),
+				},
+			})
+		}
 	}
 
 	// Send done signal
-	stream.Send(&gamev1.AskQuestionResponse{
+	send(&gamev1.AskQuestionResponse{
 		Chunk: &gamev1.AskQuestionResponse_Done{
 			Done: true,
 		},
+		Degraded: fellBack(),
 	})
 
 	return nil
@@ -260,7 +491,7 @@ func (h *GameHandler) SecondaryCheck(
 	}
 
 	// Use a secondary check
-	if err := h.firestore.UseSecondaryCheck(ctx, req.Msg.SessionId); err != nil {
+	if err := h.firestore.UseSecondaryCheck(ctx, req.Msg.SessionId, req.Msg.CaseId); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -277,11 +508,14 @@ func (h *GameHandler) SecondaryCheck(
 	return connect.NewResponse(response), nil
 }
 
-// ResolveCase handles player decision and returns verdict
+// ResolveCase handles player decision and returns verdict. Retrying a
+// decided case returns the stored resolution instead of scoring it again.
 func (h *GameHandler) ResolveCase(
 	ctx context.Context,
 	req *connect.Request[gamev1.ResolveCaseRequest],
 ) (*connect.Response[gamev1.ResolveCaseResponse], error) {
+	ctx = h.usage.WithSession(ctx, req.Msg.SessionId)
+
 	session, err := h.firestore.GetSession(ctx, req.Msg.SessionId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
@@ -292,6 +526,20 @@ func (h *GameHandler) ResolveCase(
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
+	// Already decided - replay the stored resolution
+	if resolution := session.Resolution(req.Msg.CaseId); resolution != nil {
+		var audio []byte
+		if resolution.ReactionAudioRef != "" {
+			stored, err := h.firestore.GetAudio(ctx, session.SessionID, resolution.ReactionAudioRef)
+			if err != nil {
+				log.Printf("Warning: %v", err)
+			} else {
+				audio = stored.Audio
+			}
+		}
+		return connect.NewResponse(h.resolveCaseResponse(session, caseData, resolution, audio)), nil
+	}
+
 	// Map decision to string
 	playerDecision := ""
 	switch req.Msg.Decision {
@@ -301,91 +549,226 @@ func (h *GameHandler) ResolveCase(
 		playerDecision = "deny"
 	case gamev1.Decision_DECISION_SECONDARY:
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("use SecondaryCheck endpoint instead"))
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("decision is required"))
+	}
+
+	// Decisions after departure don't count
+	resolvedAt := time.Now()
+	clock := shift.NewClock(session.ClockStartedAt(), session.ShiftLength)
+	if clock.Departed(resolvedAt) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the shuttle has departed"))
 	}
 
 	// Check if correct
 	correct := (playerDecision == caseData.CorrectDecision)
 
-	// Calculate score
-	scoreDelta := 10
-	if !correct {
-		scoreDelta = -15
+	// Time from serving the case to the decision
+	var decisionTime time.Duration
+	if clock.Enabled() && !caseData.ServedAt.IsZero() {
+		decisionTime = resolvedAt.Sub(caseData.ServedAt)
 	}
 
-	// Update session
-	if err := h.firestore.UpdateScore(ctx, req.Msg.SessionId, scoreDelta, correct); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	// Calculate score
+	flagged := make([]scoring.Field, 0, len(req.Msg.FlaggedFields))
+	flaggedFields := make([]models.Violation, 0, len(req.Msg.FlaggedFields))
+	for _, f := range req.Msg.FlaggedFields {
+		flagged = append(flagged, scoring.Field{Document: f.DocumentType, Name: f.Field})
+		flaggedFields = append(flaggedFields, models.Violation{Document: f.DocumentType, Field: f.Field})
 	}
-
-	// Move to next case
-	if err := h.firestore.IncrementCaseIndex(ctx, req.Msg.SessionId); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	violations := make([]scoring.Field, 0, len(caseData.Violations))
+	for _, v := range caseData.Violations {
+		violations = append(violations, scoring.Field{Document: v.Document, Name: v.Field})
+	}
+	// Scored in the transaction that records the decision, against the
+	// stored streak and citations
+	score := func(session *models.Session, resolution *models.Resolution) {
+		secondaryChecksUsed := caseData.SecondaryChecksUsed
+		for _, c := range session.Cases {
+			if c.CaseID == caseData.CaseID {
+				secondaryChecksUsed = c.SecondaryChecksUsed
+			}
+		}
+		breakdown := h.scorer.Score(scoring.Input{
+			Correct:             correct,
+			Decision:            playerDecision,
+			DecisionTime:        decisionTime,
+			Streak:              session.Streak,
+			Citations:           session.Citations,
+			SecondaryChecksUsed: secondaryChecksUsed,
+			FlaggedFields:       flagged,
+			ViolationFields:     violations,
+		})
+		resolution.ScoreDelta = breakdown.Total()
+		resolution.ScoreBreakdown = make([]models.ScoreItem, len(breakdown.Items))
+		for i, item := range breakdown.Items {
+			resolution.ScoreBreakdown[i] = models.ScoreItem{Code: item.Code, Label: item.Label, Points: item.Points}
+		}
 	}
 
 	// Generate verdict
-	verdict, err := h.gemini.GenerateVerdict(ctx, *caseData, playerDecision)
+	textCtx, fellBack := resilience.TrackFallbacks(ctx)
+	verdict, err := h.content.GenerateVerdict(textCtx, *caseData, playerDecision)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	// Generate NPC reaction (thank you or insult)
-	npcReaction, err := h.gemini.GenerateNPCReaction(ctx, *caseData, playerDecision, correct)
+	npcReaction, err := h.content.GenerateNPCReaction(textCtx, *caseData, playerDecision, correct)
 	if err != nil {
 		log.Printf("Warning: Failed to generate NPC reaction: %v", err)
 		npcReaction = "..." // Fallback
+		resilience.Fallback(textCtx)
+	}
+
+	// Record the decision, score and move to the next case in one step
+	resolution, recorded, err := h.firestore.ResolveCase(ctx, req.Msg.SessionId, models.Resolution{
+		CaseID:        req.Msg.CaseId,
+		Decision:      playerDecision,
+		FlaggedFields: flaggedFields,
+		Outcome:       models.OutcomeFor(playerDecision, correct),
+		Correct:       correct,
+		DecisionTime:  decisionTime,
+		ResolvedAt:    resolvedAt,
+		Verdict:       verdict,
+		Reaction:      npcReaction,
+		Degraded:      fellBack(),
+	}, score)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Let the worker remember this decision, and voice their reaction
+	var audio []byte
+	if recorded {
+		h.recordEncounter(ctx, session, caseData, playerDecision, correct)
+		audio = h.voiceReaction(ctx, session.SessionID, caseData, resolution, audioFormat(req.Msg.AudioFormat))
 	}
 
+	// Keep generating ahead of the player
+	if session.Pipeline {
+		h.fillPipeline(session.SessionID)
+	}
+
+	// Refresh session for updated score
+	if refreshed, err := h.firestore.GetSession(ctx, req.Msg.SessionId); err == nil {
+		session = refreshed
+	}
+
+	return connect.NewResponse(h.resolveCaseResponse(session, caseData, resolution, audio)), nil
+}
+
+// voiceReaction voices the NPC reaction to a new resolution and returns the
+// audio. The audio is stored beside the session and referenced from the
+// resolution, so that replays return it without synthesizing it again.
+func (h *GameHandler) voiceReaction(ctx context.Context, sessionID string, caseData *models.Case, resolution *models.Resolution, format tts.Format) []byte {
 	// Determine emotion for voice delivery
-	var emotion elevenlabs.EmotionType
-	approved := (playerDecision == "approve")
-
-	if approved && correct {
-		emotion = elevenlabs.EmotionHappy // Gratitude
-	} else if approved && !correct {
-		emotion = elevenlabs.EmotionNervous // Got away with it
-	} else if !approved && correct {
-		emotion = elevenlabs.EmotionAngry // Fair denial - frustrated
-	} else {
-		emotion = elevenlabs.EmotionFurious // Unfair denial - RAGE
+	var emotion tts.Emotion
+	switch resolution.Outcome {
+	case models.OutcomeCorrectApprove:
+		emotion = tts.EmotionHappy // Gratitude
+	case models.OutcomeWrongApprove:
+		emotion = tts.EmotionRelieved // Got away with it
+	case models.OutcomeCorrectDeny:
+		emotion = tts.EmotionAngry // Fair denial - frustrated
+	default:
+		emotion = tts.EmotionFurious // Unfair denial - RAGE
 	}
 
-	// Generate audio for NPC reaction with emotion
-	var reactionAudio []byte
-	audioData, err := h.elevenlabs.TextToSpeechWithEmotion(ctx, caseData.NPC.VoiceID, npcReaction, emotion)
+	speech, err := h.speech.SynthesizeTimed(lexicon.WithPronunciations(ctx, caseData.NPC.Pronunciations), caseData.NPC.VoiceID, resolution.Reaction, emotion, format)
 	if err != nil {
 		log.Printf("Warning: Failed to generate reaction audio: %v", err)
 		// Continue without audio - it's optional
-	} else if audioData != nil {
-		reactionAudio = audioData
+		resolution.Degraded = true
+	} else if speech != nil {
+		ref, err := h.firestore.SaveAudio(ctx, sessionID, caseData.CaseID+"-reaction", models.StoredAudio{Audio: speech.Audio, Format: string(speech.Format)})
+		if err != nil {
+			// This response still carries the audio; replays go text-only
+			log.Printf("Warning: %v", err)
+		}
+		resolution.ReactionAudioRef = ref
+		resolution.ReactionAudioFormat = string(speech.Format)
+		resolution.ReactionSubtitles = speech.Words
 	}
 
-	// Determine outcome
-	outcome := gamev1.CaseOutcome_CASE_OUTCOME_UNSPECIFIED
-	if correct && playerDecision == "approve" {
-		outcome = gamev1.CaseOutcome_CASE_OUTCOME_CORRECT_APPROVE
-	} else if correct && playerDecision == "deny" {
-		outcome = gamev1.CaseOutcome_CASE_OUTCOME_CORRECT_DENY
-	} else if !correct && playerDecision == "approve" {
-		outcome = gamev1.CaseOutcome_CASE_OUTCOME_WRONG_APPROVE
-	} else if !correct && playerDecision == "deny" {
-		outcome = gamev1.CaseOutcome_CASE_OUTCOME_WRONG_DENY
+	if err := h.firestore.StoreReactionAudio(ctx, sessionID, *resolution); err != nil {
+		log.Printf("Warning: %v", err)
 	}
+	if speech == nil {
+		return nil
+	}
+	return speech.Audio
+}
 
-	// Refresh session for updated score
-	session, _ = h.firestore.GetSession(ctx, req.Msg.SessionId)
+// resolveCaseResponse builds the ResolveCase response from a stored
+// resolution and its reaction audio. A replay that arrives while the
+// reaction is still being voiced gets the reaction as text only.
+func (h *GameHandler) resolveCaseResponse(session *models.Session, caseData *models.Case, resolution *models.Resolution, audio []byte) *gamev1.ResolveCaseResponse {
+	timeBonus := 0
+	scoreItems := make([]*gamev1.ScoreItem, len(resolution.ScoreBreakdown))
+	for i, item := range resolution.ScoreBreakdown {
+		if item.Code == "speed" {
+			timeBonus += item.Points
+		}
+		scoreItems[i] = &gamev1.ScoreItem{
+			Code:   item.Code,
+			Label:  item.Label,
+			Points: int32(item.Points),
+		}
+	}
 
-	response := &gamev1.ResolveCaseResponse{
-		Correct:             correct,
-		Verdict:             verdict,
-		ContradictionsFound: caseData.Contradictions,
-		ScoreDelta:          int32(scoreDelta),
-		TotalScore:          int32(session.Score),
-		Outcome:             outcome,
-		NpcReactionText:     npcReaction,
-		NpcReactionAudio:    reactionAudio,
+	return &gamev1.ResolveCaseResponse{
+		Correct:                  resolution.Correct,
+		Verdict:                  resolution.Verdict,
+		ContradictionsFound:      caseData.Contradictions,
+		ScoreDelta:               int32(resolution.ScoreDelta),
+		TotalScore:               int32(session.Score),
+		Outcome:                  toProtoOutcome(resolution.Outcome),
+		NpcReactionText:          resolution.Reaction,
+		NpcReactionAudio:         audio,
+		ReactionSubtitles:        toProtoSubtitles(resolution.ReactionSubtitles),
+		NpcReactionAudioMimeType: audioMimeType(audio, tts.Format(resolution.ReactionAudioFormat)),
+		TimeBonus:                int32(timeBonus),
+		DecisionSeconds:          int32(resolution.DecisionTime.Seconds()),
+		ScoreBreakdown:           scoreItems,
+		Streak:                   int32(resolution.Streak),
+		Citations:                int32(resolution.Citations),
+		Degraded:                 resolution.Degraded,
 	}
+}
 
-	return connect.NewResponse(response), nil
+// toProtoSubtitles converts word timings to a subtitle track, or nil if there are none
+func toProtoSubtitles(words []models.SubtitleWord) *gamev1.SubtitleTrack {
+	if len(words) == 0 {
+		return nil
+	}
+
+	track := &gamev1.SubtitleTrack{Words: make([]*gamev1.SubtitleWord, len(words))}
+	for i, word := range words {
+		track.Words[i] = &gamev1.SubtitleWord{
+			Text:    word.Text,
+			Offset:  int32(word.Offset),
+			StartMs: int32(word.Start.Milliseconds()),
+			EndMs:   int32(word.End.Milliseconds()),
+		}
+	}
+	return track
+}
+
+// toProtoOutcome maps a stored outcome to the proto enum
+func toProtoOutcome(outcome models.Outcome) gamev1.CaseOutcome {
+	switch outcome {
+	case models.OutcomeCorrectApprove:
+		return gamev1.CaseOutcome_CASE_OUTCOME_CORRECT_APPROVE
+	case models.OutcomeCorrectDeny:
+		return gamev1.CaseOutcome_CASE_OUTCOME_CORRECT_DENY
+	case models.OutcomeWrongApprove:
+		return gamev1.CaseOutcome_CASE_OUTCOME_WRONG_APPROVE
+	case models.OutcomeWrongDeny:
+		return gamev1.CaseOutcome_CASE_OUTCOME_WRONG_DENY
+	default:
+		return gamev1.CaseOutcome_CASE_OUTCOME_UNSPECIFIED
+	}
 }
 
 // GetSessionStatus returns current session stats
@@ -401,20 +784,241 @@ func (h *GameHandler) GetSessionStatus(
 
 	log.Printf("GetSessionStatus called for session %s:", req.Msg.SessionId)
 	log.Printf("  CurrentCaseIndex: %d", session.CurrentCaseIndex)
-	log.Printf("  Total Cases: %d", len(session.Cases))
+	log.Printf("  Total Cases: %d", session.CaseCount())
 	log.Printf("  Score: %d", session.Score)
 	log.Printf("  Correct: %d", session.CorrectDecisions)
 	log.Printf("  Incorrect: %d", session.IncorrectDecisions)
 
+	now := time.Now()
+	clock := shift.NewClock(session.ClockStartedAt(), session.ShiftLength)
+	departed := clock.Departed(now)
+
 	response := &gamev1.GetSessionStatusResponse{
 		CasesCompleted:           int32(session.CurrentCaseIndex),
-		TotalCases:               int32(len(session.Cases)),
+		TotalCases:               int32(session.CaseCount()),
+		TotalScore:               int32(session.Score),
+		CorrectDecisions:         int32(session.CorrectDecisions),
+		IncorrectDecisions:       int32(session.IncorrectDecisions),
+		RemainingSecondaryChecks: int32(session.RemainingSecondaryChecks),
+		SessionComplete:          session.Complete(departed),
+		RemainingShiftSeconds:    int32(clock.Remaining(now).Seconds()),
+		ShiftClock:               clock.GameTime(now),
+		ShuttleDeparted:          departed,
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// GetCampaign returns a player's campaign progress
+func (h *GameHandler) GetCampaign(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetCampaignRequest],
+) (*connect.Response[gamev1.GetCampaignResponse], error) {
+	if req.Msg.PlayerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("player_id is required"))
+	}
+
+	campaign, err := h.firestore.GetCampaign(ctx, req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Players without a campaign get an empty day 0
+	if campaign == nil {
+		return connect.NewResponse(&gamev1.GetCampaignResponse{}), nil
+	}
+
+	days := make([]*gamev1.CampaignDay, len(campaign.Days))
+	for i, day := range campaign.Days {
+		days[i] = &gamev1.CampaignDay{
+			Day:        int32(day.Day),
+			GameDate:   day.GameDate,
+			Rules:      day.Rules,
+			StoryEvent: day.StoryEvent,
+			SessionId:  day.SessionID,
+		}
+	}
+
+	response := &gamev1.GetCampaignResponse{
+		Day:      int32(campaign.Day),
+		GameDate: campaign.GameDate,
+		Rules:    campaign.Rules,
+		Days:     days,
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// GetShiftReport returns the end-of-shift debrief with a per-case breakdown
+func (h *GameHandler) GetShiftReport(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetShiftReportRequest],
+) (*connect.Response[gamev1.GetShiftReportResponse], error) {
+	ctx = h.usage.WithSession(ctx, req.Msg.SessionId)
+
+	session, err := h.firestore.GetSession(ctx, req.Msg.SessionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	report := session.Report()
+
+	cases := make([]*gamev1.CaseDebrief, len(report.Cases))
+	for i, cr := range report.Cases {
+		cases[i] = &gamev1.CaseDebrief{
+			CaseId:              cr.CaseID,
+			NpcName:             cr.NPCName,
+			PlayerDecision:      toProtoDecision(cr.Decision),
+			CorrectDecision:     toProtoDecision(cr.CorrectDecision),
+			Correct:             cr.Correct,
+			CaughtViolations:    toProtoViolations(cr.CaughtViolations),
+			MissedViolations:    toProtoViolations(cr.MissedViolations),
+			QuestionsAsked:      cr.QuestionsAsked,
+			SecondaryChecksUsed: int32(cr.SecondaryChecksUsed),
+			DecisionSeconds:     int32(cr.DecisionTime.Seconds()),
+		}
+	}
+
+	// The supervisor summary is optional - the report stands on its own
+	summary := ""
+	if req.Msg.IncludeSummary {
+		summary, err = h.content.GenerateShiftSummary(ctx, report)
+		if err != nil {
+			log.Printf("Warning: Failed to generate shift summary: %v", err)
+		}
+	}
+
+	response := &gamev1.GetShiftReportResponse{
+		SessionId:          report.SessionID,
+		GameDate:           report.GameDate,
+		TotalScore:         int32(report.Score),
+		CorrectDecisions:   int32(report.CorrectDecisions),
+		IncorrectDecisions: int32(report.IncorrectDecisions),
+		Citations:          int32(report.Citations),
+		TotalCases:         int32(report.TotalCases),
+		Cases:              cases,
+		SupervisorSummary:  summary,
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// toProtoDecision maps a stored "approve"/"deny" decision to the proto enum
+func toProtoDecision(decision string) gamev1.Decision {
+	switch decision {
+	case "approve":
+		return gamev1.Decision_DECISION_APPROVE
+	case "deny":
+		return gamev1.Decision_DECISION_DENY
+	default:
+		return gamev1.Decision_DECISION_UNSPECIFIED
+	}
+}
+
+func toProtoViolations(violations []models.Violation) []*gamev1.Violation {
+	out := make([]*gamev1.Violation, len(violations))
+	for i, v := range violations {
+		out[i] = &gamev1.Violation{
+			DocumentType: v.Document,
+			Field:        v.Field,
+			Rule:         v.Rule,
+		}
+	}
+	return out
+}
+
+// ResumeSession returns the full current state of a session after a reload or disconnect
+func (h *GameHandler) ResumeSession(
+	ctx context.Context,
+	req *connect.Request[gamev1.ResumeSessionRequest],
+) (*connect.Response[gamev1.ResumeSessionResponse], error) {
+	ctx = h.usage.WithSession(ctx, req.Msg.SessionId)
+
+	session, err := h.firestore.GetSession(ctx, req.Msg.SessionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	// Only the player who started a session can resume it. Sessions
+	// started without a player id have no owner to check, so they can't be.
+	if session.PlayerID == "" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("session was started without a player id and can't be resumed"))
+	}
+	if session.PlayerID != req.Msg.PlayerId {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("session belongs to another player"))
+	}
+
+	now := time.Now()
+	clock := shift.NewClock(session.ClockStartedAt(), session.ShiftLength)
+	departed := clock.Departed(now)
+
+	response := &gamev1.ResumeSessionResponse{
+		SessionId:                session.SessionID,
+		GameDate:                 session.GameDate,
+		Rules:                    session.Rules,
 		TotalScore:               int32(session.Score),
 		CorrectDecisions:         int32(session.CorrectDecisions),
 		IncorrectDecisions:       int32(session.IncorrectDecisions),
 		RemainingSecondaryChecks: int32(session.RemainingSecondaryChecks),
-		SessionComplete:          session.CurrentCaseIndex >= len(session.Cases),
+		CasesCompleted:           int32(session.CurrentCaseIndex),
+		TotalCases:               int32(session.CaseCount()),
+		SessionComplete:          session.Complete(departed),
+		CampaignDay:              int32(session.CampaignDay),
+		StoryEvent:               session.StoryEvent(),
+		RemainingShiftSeconds:    int32(clock.Remaining(now).Seconds()),
+		ShiftClock:               clock.GameTime(now),
+	}
+
+	// Lazily generated cases may not exist yet - GetNextCase will create them
+	if !response.SessionComplete && session.CurrentCaseIndex < len(session.Cases) {
+		currentCase := session.Cases[session.CurrentCaseIndex]
+		h.revoiceOpening(ctx, &currentCase, audioFormat(req.Msg.AudioFormat))
+		response.CurrentCase = h.caseResponse(session, &currentCase, clock, now)
+		for _, line := range currentCase.Transcript {
+			response.Transcript = append(response.Transcript, &gamev1.DialogueLine{
+				Question: line.Question,
+				Answer:   line.Answer,
+			})
+		}
 	}
 
 	return connect.NewResponse(response), nil
 }
+
+// ListMySessions returns a player's sessions, newest first
+func (h *GameHandler) ListMySessions(
+	ctx context.Context,
+	req *connect.Request[gamev1.ListMySessionsRequest],
+) (*connect.Response[gamev1.ListMySessionsResponse], error) {
+	if req.Msg.PlayerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("player_id is required"))
+	}
+
+	sessions, err := h.firestore.ListSessions(ctx, req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	now := time.Now()
+	summaries := make([]*gamev1.SessionSummary, 0, len(sessions))
+	for _, session := range sessions {
+		clock := shift.NewClock(session.ClockStartedAt(), session.ShiftLength)
+		complete := session.Complete(clock.Departed(now))
+		if complete && req.Msg.UnfinishedOnly {
+			continue
+		}
+
+		summaries = append(summaries, &gamev1.SessionSummary{
+			SessionId:       session.SessionID,
+			GameDate:        session.GameDate,
+			CampaignDay:     int32(session.CampaignDay),
+			CasesCompleted:  int32(session.CurrentCaseIndex),
+			TotalCases:      int32(session.CaseCount()),
+			TotalScore:      int32(session.Score),
+			SessionComplete: complete,
+			StartedAt:       session.ShiftStartedAt.Unix(),
+		})
+	}
+
+	return connect.NewResponse(&gamev1.ListMySessionsResponse{Sessions: summaries}), nil
+}
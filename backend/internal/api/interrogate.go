@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gamev1 "github.com/ttrubel/send-me-home/gen/game/v1"
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+)
+
+// interrogateAudioChunkSize is how much answer audio goes in one message
+const interrogateAudioChunkSize = 32 << 10
+
+// Interrogate holds a live conversation with the NPC of one case. Each
+// question starts a new turn; a new question or an interrupt cancels the
+// answer in progress, including its dialogue generation and synthesis.
+func (h *GameHandler) Interrogate(
+	ctx context.Context,
+	stream *connect.BidiStream[gamev1.InterrogateRequest, gamev1.InterrogateResponse],
+) error {
+	first, err := stream.Receive()
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to receive start: %w", err))
+	}
+	start := first.GetStart()
+	if start == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("first message must be start"))
+	}
+	ctx = h.usage.WithSession(ctx, start.SessionId)
+
+	caseData, err := h.firestore.GetCase(ctx, start.SessionId, start.CaseId)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	conv := &interrogation{
+		h:         h,
+		stream:    stream,
+		sessionID: start.SessionId,
+		caseData:  caseData,
+		mimeType:  start.MimeType,
+		format:    audioFormat(start.AudioFormat),
+	}
+	defer conv.stop()
+
+	var audio []byte
+	for {
+		msg, err := stream.Receive()
+		if errors.Is(err, io.EOF) {
+			// Let the last answer finish before closing the stream
+			conv.wait()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch input := msg.Input.(type) {
+		case *gamev1.InterrogateRequest_Question:
+			if err := conv.ask(ctx, input.Question, nil); err != nil {
+				return err
+			}
+		case *gamev1.InterrogateRequest_AudioChunk:
+			if len(audio)+len(input.AudioChunk) > maxVoiceQuestionBytes {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("question audio exceeds %d bytes", maxVoiceQuestionBytes))
+			}
+			audio = append(audio, input.AudioChunk...)
+		case *gamev1.InterrogateRequest_AudioEnd:
+			if err := conv.ask(ctx, "", append([]byte{}, audio...)); err != nil {
+				return err
+			}
+			audio = nil
+		case *gamev1.InterrogateRequest_Interrupt:
+			if err := conv.stop(); err != nil {
+				return err
+			}
+		case *gamev1.InterrogateRequest_Start:
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start can only be sent once"))
+		}
+	}
+}
+
+// interrogation is the state of one Interrogate stream. Only the running
+// turn sends; the receive loop sends only after the turn has stopped.
+type interrogation struct {
+	h         *GameHandler
+	stream    *connect.BidiStream[gamev1.InterrogateRequest, gamev1.InterrogateResponse]
+	sessionID string
+	caseData  *models.Case
+	mimeType  string
+	format    tts.Format // Preferred answer audio format
+
+	turn     int32
+	cancel   context.CancelFunc
+	done     chan struct{}
+	finished bool // Set by the turn before done is closed if it answered fully
+}
+
+// ask cancels the answer in progress and starts a new turn for a typed
+// question or spoken audio
+func (c *interrogation) ask(ctx context.Context, question string, audio []byte) error {
+	if err := c.stop(); err != nil {
+		return err
+	}
+
+	c.turn++
+	turnCtx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+	c.done = make(chan struct{})
+	c.finished = false
+
+	go func(turn int32) {
+		defer close(c.done)
+		c.finished = c.answer(turnCtx, turn, question, audio)
+	}(c.turn)
+
+	return nil
+}
+
+// stop cancels the answer in progress, if any, and tells the client it was cut off
+func (c *interrogation) stop() error {
+	if c.cancel == nil {
+		return nil
+	}
+
+	c.cancel()
+	<-c.done
+	c.cancel = nil
+
+	if c.finished {
+		return nil
+	}
+	return c.stream.Send(&gamev1.InterrogateResponse{Turn: c.turn, Chunk: &gamev1.InterrogateResponse_Interrupted{Interrupted: true}})
+}
+
+// wait lets the answer in progress finish
+func (c *interrogation) wait() {
+	if c.cancel == nil {
+		return
+	}
+
+	<-c.done
+	c.cancel()
+	c.cancel = nil
+}
+
+// answer runs one turn and reports whether it completed
+func (c *interrogation) answer(ctx context.Context, turn int32, question string, audio []byte) bool {
+	ctx, fellBack := resilience.TrackFallbacks(ctx)
+
+	if audio != nil {
+		if len(audio) == 0 {
+			return c.fail(ctx, turn, fmt.Errorf("no audio received"))
+		}
+
+		transcript, err := c.h.transcribe(ctx, audio, c.mimeType)
+		if err != nil {
+			return c.fail(ctx, turn, err)
+		}
+		if c.stream.Send(&gamev1.InterrogateResponse{Turn: turn, Chunk: &gamev1.InterrogateResponse_Transcript{Transcript: transcript}}) != nil {
+			return false
+		}
+		question = transcript
+	}
+	if question == "" {
+		return c.fail(ctx, turn, fmt.Errorf("no question asked"))
+	}
+
+	reply, err := c.h.content.GenerateDialogue(ctx, models.DialogueContext{
+		Question:   question,
+		CaseTruth:  c.caseData.Truth,
+		NPCProfile: c.caseData.NPC,
+	})
+	if err != nil || ctx.Err() != nil {
+		return c.fail(ctx, turn, err)
+	}
+
+	// Keep the exchange for resuming the session and the shift report
+	line := models.DialogueLine{Question: question, Answer: reply.Text, Emotion: reply.Emotion, AskedAt: time.Now()}
+	if err := c.h.firestore.RecordDialogue(context.WithoutCancel(ctx), c.sessionID, c.caseData.CaseID, line); err != nil {
+		log.Printf("Warning: Failed to record dialogue: %v", err)
+	}
+
+	if c.stream.Send(&gamev1.InterrogateResponse{Turn: turn, Chunk: &gamev1.InterrogateResponse_TextChunk{TextChunk: reply.Text}, Degraded: fellBack()}) != nil {
+		return false
+	}
+
+	if !c.streamAudio(ctx, turn, reply.Text, tts.ParseEmotion(reply.Emotion)) {
+		return false
+	}
+
+	return c.stream.Send(&gamev1.InterrogateResponse{Turn: turn, Chunk: &gamev1.InterrogateResponse_Done{Done: true}, Degraded: fellBack()}) == nil
+}
+
+// streamAudio sends the answer audio as it is synthesized. Failing to
+// synthesize is not fatal; it reports false only if the turn was cut off.
+func (c *interrogation) streamAudio(ctx context.Context, turn int32, text string, emotion tts.Emotion) bool {
+	audio, err := c.h.speech.SynthesizeStream(lexicon.WithPronunciations(ctx, c.caseData.NPC.Pronunciations), c.caseData.NPC.VoiceID, text, emotion, c.format)
+	if err != nil {
+		log.Printf("Warning: Failed to generate audio for response: %v", err)
+		resilience.Fallback(ctx)
+		return ctx.Err() == nil
+	}
+	if audio == nil {
+		return true
+	}
+	defer audio.Close()
+
+	buf := make([]byte, interrogateAudioChunkSize)
+	for {
+		n, err := io.ReadFull(audio, buf)
+		if n > 0 {
+			chunk := &gamev1.InterrogateResponse_AudioChunk{AudioChunk: append([]byte(nil), buf[:n]...)}
+			if c.stream.Send(&gamev1.InterrogateResponse{Turn: turn, Chunk: chunk, AudioMimeType: audio.Format.MimeType()}) != nil {
+				return false
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return true
+		}
+		if err != nil {
+			log.Printf("Warning: Failed to stream audio for response: %v", err)
+			resilience.Fallback(ctx)
+			return ctx.Err() == nil
+		}
+	}
+}
+
+// fail reports a turn that couldn't be answered, unless it was cut off
+func (c *interrogation) fail(ctx context.Context, turn int32, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	return c.stream.Send(&gamev1.InterrogateResponse{Turn: turn, Chunk: &gamev1.InterrogateResponse_Error{Error: err.Error()}}) == nil
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// Just-in-time generation settings
+const (
+	pipelineUpfront     = 2                // Cases generated before the session starts
+	pipelineLookahead   = 3                // Cases kept ready beyond the current one
+	pipelineWaitTimeout = 30 * time.Second // How long GetNextCase waits for a case
+	pipelineFillTimeout = 5 * time.Minute  // Bounds one background fill run
+)
+
+// pipelineFillers makes sure only one background fill runs per session on
+// this instance. AppendCase keeps fills on different instances from
+// generating the same case twice.
+type pipelineFillers struct {
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+func newPipelineFillers() *pipelineFillers {
+	return &pipelineFillers{running: make(map[string]bool)}
+}
+
+func (p *pipelineFillers) start(sessionID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running[sessionID] {
+		return false
+	}
+	p.running[sessionID] = true
+	return true
+}
+
+func (p *pipelineFillers) done(sessionID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.running, sessionID)
+}
+
+// pipelineTarget returns how many cases a pipelined session should have
+// generated. Adaptive sessions don't generate ahead, so that each case
+// matches the player's accuracy up to the decision before it.
+func pipelineTarget(session *models.Session) int {
+	lookahead := pipelineLookahead
+	if session.Adaptive {
+		lookahead = 0
+	}
+	return min(session.CurrentCaseIndex+1+lookahead, session.CaseCount())
+}
+
+// fillPipeline generates cases in the background until the session is
+// pipelineLookahead cases ahead of the player
+func (h *GameHandler) fillPipeline(sessionID string) {
+	if !h.pipelines.start(sessionID) {
+		return
+	}
+
+	go func() {
+		defer h.pipelines.done(sessionID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), pipelineFillTimeout)
+		defer cancel()
+		ctx = h.usage.WithSession(ctx, sessionID)
+
+		for {
+			session, err := h.firestore.GetSession(ctx, sessionID)
+			if err != nil {
+				log.Printf("Warning: Pipeline for session %s stopped: %v", sessionID, err)
+				return
+			}
+			if len(session.Cases) >= pipelineTarget(session) {
+				return
+			}
+
+			if _, err := h.generateNextCase(ctx, session); err != nil {
+				log.Printf("Warning: Pipeline for session %s failed to generate case %d: %v", sessionID, len(session.Cases)+1, err)
+				return
+			}
+		}
+	}()
+}
+
+// waitForCase waits for the background pipeline to generate the case at index
+func (h *GameHandler) waitForCase(ctx context.Context, sessionID string, index int) (*models.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, pipelineWaitTimeout)
+	defer cancel()
+
+	session, err := h.firestore.WaitForCase(ctx, sessionID, index)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("case %d is still being generated, try again", index+1))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return session, nil
+}
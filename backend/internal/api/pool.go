@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/ttrubel/send-me-home/internal/casepool"
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// poolCandidates is how many pooled cases are loaded to pick from
+const poolCandidates = 50
+
+// pickPoolRuleSet returns a random pooled rule set with at least need cases
+// at the given difficulty, or nil if the pool has none
+func (h *GameHandler) pickPoolRuleSet(ctx context.Context, difficulty models.Difficulty, need int) *models.PoolRuleSet {
+	if !h.cfg.CasePool {
+		return nil
+	}
+
+	ruleSets, err := h.firestore.ListPoolRuleSets(ctx)
+	if err != nil {
+		log.Printf("Warning: Failed to list case pool: %v", err)
+		return nil
+	}
+
+	var ready []models.PoolRuleSet
+	for _, ruleSet := range ruleSets {
+		if ruleSet.CaseCounts[string(difficulty)] >= need {
+			ready = append(ready, ruleSet)
+		}
+	}
+	if len(ready) == 0 {
+		return nil
+	}
+
+	return &ready[h.draws("pool rule set", string(difficulty)).IntN(len(ready))]
+}
+
+// takePooledCases returns up to count pooled cases for the rule set key, moved to
+// the game date and skipping workers already in existing. It returns fewer
+// when the pool runs short.
+func (h *GameHandler) takePooledCases(ctx context.Context, key string, gameDate string, difficulty models.Difficulty, count int, existing []models.Case) []models.Case {
+	if !h.cfg.CasePool || key == "" || count <= 0 {
+		return nil
+	}
+
+	pooled, err := h.firestore.ListPooledCases(ctx, key, difficulty, poolCandidates)
+	if err != nil {
+		log.Printf("Warning: Failed to load pooled cases: %v", err)
+		return nil
+	}
+
+	seen := make(map[string]bool, len(existing))
+	for _, caseData := range existing {
+		seen[caseData.NPC.Name] = true
+	}
+
+	r := h.draws("pooled cases", key, gameDate, string(difficulty), strconv.Itoa(len(existing)))
+	r.Shuffle(len(pooled), func(i, j int) { pooled[i], pooled[j] = pooled[j], pooled[i] })
+
+	var cases []models.Case
+	for _, p := range pooled {
+		if len(cases) == count {
+			break
+		}
+		if seen[p.Case.NPC.Name] {
+			continue
+		}
+		seen[p.Case.NPC.Name] = true
+
+		caseData := p.Case
+		caseData.ShiftDates(models.DaysBetween(p.GameDate, gameDate))
+		cases = append(cases, caseData)
+	}
+
+	return cases
+}
+
+// contributeToPool adds freshly generated cases to the session's rule set
+// in the pool so later sessions can reuse them. Cases tied to a player's
+// roster are skipped.
+func (h *GameHandler) contributeToPool(ctx context.Context, session *models.Session, difficulty models.Difficulty, cases []models.Case) {
+	if !h.cfg.CasePool || session.PoolKey == "" {
+		return
+	}
+
+	var poolable []models.Case
+	for _, caseData := range cases {
+		if caseData.NPC.PreviousEncounters > 0 || casepool.Validate(caseData) != nil {
+			continue
+		}
+
+		// Roster IDs belong to the player who met the worker
+		caseData.NPC.NPCID = ""
+		caseData.NPC.PortraitSeed = ""
+		caseData.NPC.History = nil
+		poolable = append(poolable, caseData)
+	}
+
+	if err := h.firestore.AddPooledCases(ctx, session.PoolKey, session.Rules, session.GameDate, difficulty, poolable); err != nil {
+		log.Printf("Warning: Failed to add cases to pool: %v", err)
+	}
+}
+
+// renumberCases gives cases sequential IDs starting after start
+func renumberCases(cases []models.Case, start int) {
+	for i := range cases {
+		cases[i].CaseID = fmt.Sprintf("case-%d", start+i+1)
+	}
+}
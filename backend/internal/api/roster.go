@@ -0,0 +1,131 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// maxReturningNPCs is how many roster workers come back per session
+const maxReturningNPCs = 2
+
+// pickReturningNPCs chooses workers from the player's roster to bring back
+// on a game date
+func (h *GameHandler) pickReturningNPCs(ctx context.Context, playerID, gameDate string) ([]models.NPCProfile, error) {
+	if playerID == "" {
+		return nil, nil
+	}
+
+	roster, err := h.firestore.ListNPCs(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(roster) == 0 {
+		return nil, nil
+	}
+
+	// Workers' IDs are random, so draw from them in name order
+	slices.SortFunc(roster, func(a, b models.RosterNPC) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.NPCID, b.NPCID))
+	})
+
+	count := min(maxReturningNPCs, len(roster))
+	returning := make([]models.NPCProfile, 0, count)
+	for _, i := range h.draws("roster", playerID, gameDate).Perm(len(roster))[:count] {
+		returning = append(returning, roster[i].Profile())
+	}
+	return returning, nil
+}
+
+// attachRoster gives every generated worker a stable identity. Returning
+// workers keep their voice, portrait and backstory; new workers join the
+// player's roster.
+func (h *GameHandler) attachRoster(ctx context.Context, playerID string, cases []models.Case, returning []models.NPCProfile) error {
+	if playerID == "" {
+		return nil
+	}
+
+	byName := make(map[string]models.NPCProfile, len(returning))
+	for _, npc := range returning {
+		byName[npc.Name] = npc
+	}
+
+	for i := range cases {
+		npc := &cases[i].NPC
+
+		if known, ok := byName[npc.Name]; ok {
+			// Each returning worker shows up at most once per batch
+			delete(byName, npc.Name)
+
+			npc.NPCID = known.NPCID
+			npc.Role = known.Role
+			npc.Department = known.Department
+			npc.VoiceID = known.VoiceID
+			npc.Casting = known.Casting
+			npc.Pronunciations = known.Pronunciations
+			npc.PortraitSeed = known.PortraitSeed
+			npc.Backstory = known.Backstory
+			npc.History = known.History
+			npc.PreviousEncounters = known.PreviousEncounters
+		} else {
+			npcID := uuid.New().String()
+			rosterNPC := &models.RosterNPC{
+				NPCID:          npcID,
+				PlayerID:       playerID,
+				Name:           npc.Name,
+				Role:           npc.Role,
+				Department:     npc.Department,
+				Personality:    npc.Personality,
+				Demeanor:       npc.Demeanor,
+				VoiceID:        npc.VoiceID,
+				Casting:        npc.Casting,
+				Pronunciations: npc.Pronunciations,
+				PortraitSeed:   npcID,
+				Backstory:      npc.Backstory,
+			}
+			if err := h.firestore.SaveNPC(ctx, rosterNPC); err != nil {
+				return fmt.Errorf("failed to add npc to roster: %w", err)
+			}
+
+			npc.NPCID = npcID
+			npc.PortraitSeed = npcID
+		}
+
+		// The badge photo follows the worker, not the case
+		npc.PortraitURL = models.PortraitURL(npc.PortraitSeed)
+		for _, doc := range cases[i].Documents {
+			if doc.Type == "employee_badge" && doc.Fields != nil {
+				doc.Fields["picture"] = npc.PortraitURL
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordEncounter stores the player's decision in the worker's memory
+func (h *GameHandler) recordEncounter(ctx context.Context, session *models.Session, caseData *models.Case, playerDecision string, correct bool) {
+	if caseData.NPC.NPCID == "" {
+		return
+	}
+
+	encounter := models.NPCEncounter{
+		SessionID:   session.SessionID,
+		CaseID:      caseData.CaseID,
+		GameDate:    session.GameDate,
+		CampaignDay: session.CampaignDay,
+		Decision:    playerDecision,
+		Correct:     correct,
+		Reason:      caseData.Truth.Reason,
+	}
+
+	if err := h.firestore.RecordEncounter(ctx, caseData.NPC.NPCID, encounter); err != nil {
+		log.Printf("Warning: Failed to record encounter for %s: %v", caseData.NPC.Name, err)
+	}
+}
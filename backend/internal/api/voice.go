@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	gamev1 "github.com/ttrubel/send-me-home/gen/game/v1"
+	"github.com/ttrubel/send-me-home/internal/budget"
+	"github.com/ttrubel/send-me-home/internal/services/stt"
+)
+
+// maxVoiceQuestionBytes caps the audio buffered for one spoken question
+const maxVoiceQuestionBytes = 10 << 20
+
+// AskQuestionByVoice transcribes a spoken question, sends the transcript back
+// for the player to confirm and answers it like AskQuestion
+func (h *GameHandler) AskQuestionByVoice(
+	ctx context.Context,
+	stream *connect.BidiStream[gamev1.VoiceQuestionRequest, gamev1.AskQuestionResponse],
+) error {
+	first, err := stream.Receive()
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to receive start: %w", err))
+	}
+	start := first.GetStart()
+	if start == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("first message must be start"))
+	}
+	ctx = h.usage.WithSession(ctx, start.SessionId)
+
+	caseData, err := h.firestore.GetCase(ctx, start.SessionId, start.CaseId)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	// Buffer audio until the player stops talking and closes their side
+	var audio []byte
+	for {
+		msg, err := stream.Receive()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk := msg.GetAudioChunk()
+		if len(audio)+len(chunk) > maxVoiceQuestionBytes {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("question audio exceeds %d bytes", maxVoiceQuestionBytes))
+		}
+		audio = append(audio, chunk...)
+	}
+	if len(audio) == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no audio received"))
+	}
+
+	question, err := h.transcribe(ctx, audio, start.MimeType)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&gamev1.AskQuestionResponse{
+		Chunk: &gamev1.AskQuestionResponse_Transcript{
+			Transcript: question,
+		},
+	}); err != nil {
+		return err
+	}
+	if question == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no speech recognized"))
+	}
+
+	return h.answerQuestion(ctx, start.SessionId, caseData, question, audioFormat(start.AudioFormat), stream.Send)
+}
+
+// transcribe turns a spoken question into text
+func (h *GameHandler) transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	if mimeType == "" {
+		mimeType = "audio/webm"
+	}
+
+	question, err := h.stt.Transcribe(ctx, audio, mimeType)
+	if errors.Is(err, stt.ErrNotConfigured) {
+		return "", connect.NewError(connect.CodeUnavailable, err)
+	}
+	if errors.Is(err, budget.ErrExhausted) {
+		return "", connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to transcribe question: %w", err))
+	}
+
+	return strings.TrimSpace(question), nil
+}
@@ -0,0 +1,487 @@
+// Package budget caps what the game spends on AI providers. Spending is
+// counted from what the usage package records and shared through a Store,
+// so budgets hold across restarts and server instances. When a budget runs
+// out, model calls fall back to mock content, speech to text-only, and new
+// sessions are refused.
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/usage"
+)
+
+// ErrExhausted is returned when a budget has run out
+var ErrExhausted = errors.New("budget exhausted")
+
+// Quota caps spending; zero fields are unlimited
+type Quota struct {
+	Tokens        int64 // LLM prompt and output tokens
+	TTSCharacters int64
+	Sessions      int64 // Sessions started
+}
+
+// Limits are the quotas a deployment enforces
+type Limits struct {
+	Daily       Quota // Across all players, per day
+	PlayerDaily Quota // Per player, per day; sessions without a player share one
+	Session     Quota // Per session; Sessions is unused
+}
+
+// ConfiguredLimits returns the limits set in the configuration
+func ConfiguredLimits(cfg *config.Config) Limits {
+	return Limits{
+		Daily: Quota{
+			Tokens:        int64(cfg.DailyTokenBudget),
+			TTSCharacters: int64(cfg.DailyTTSCharacterBudget),
+			Sessions:      int64(cfg.DailySessionBudget),
+		},
+		PlayerDaily: Quota{
+			Tokens:        int64(cfg.PlayerDailyTokenBudget),
+			TTSCharacters: int64(cfg.PlayerDailyTTSCharacterBudget),
+			Sessions:      int64(cfg.PlayerDailySessionBudget),
+		},
+		Session: Quota{
+			Tokens:        int64(cfg.SessionTokenBudget),
+			TTSCharacters: int64(cfg.SessionTTSCharacterBudget),
+		},
+	}
+}
+
+// Store keeps the spending counted under each budget, shared by every server
+type Store interface {
+	// AddSpending adds to the spending counted under each key and returns
+	// the totals after
+	AddSpending(ctx context.Context, deltas map[string]models.Spending) (map[string]models.Spending, error)
+
+	// AdmitSession counts a session under each key unless one of them has
+	// reached its limit, and returns that key's index, or -1
+	AdmitSession(ctx context.Context, keys []string, limits []int64) (int, error)
+
+	// SessionOwner returns the player who owns a session, or "" if nobody does
+	SessionOwner(ctx context.Context, sessionID string) (string, error)
+}
+
+// resource is something quotas cap per call
+type resource string
+
+const (
+	tokens     resource = "token"
+	characters resource = "TTS character"
+)
+
+// limit returns the quota's cap on res
+func (q Quota) limit(res resource) int64 {
+	if res == tokens {
+		return q.Tokens
+	}
+	return q.TTSCharacters
+}
+
+// capsSpending reports whether the quota caps tokens or characters
+func (q Quota) capsSpending() bool {
+	return q.Tokens > 0 || q.TTSCharacters > 0
+}
+
+// used returns how much of res s spent
+func used(s models.Spending, res resource) int64 {
+	if res == tokens {
+		return s.Tokens
+	}
+	return s.TTSCharacters
+}
+
+// dailyKey is the store key of a day's budget across all players
+func dailyKey(day string) string {
+	return "daily-" + day
+}
+
+// playerKey is the store key of a player's budget for a day. Sessions
+// started without a player id share one budget, so leaving the id out
+// doesn't lift the limit.
+func playerKey(day, playerID string) string {
+	if playerID == "" {
+		return "player-" + day
+	}
+	return "player-" + day + "-" + playerID
+}
+
+// playerName names a player in errors
+func playerName(playerID string) string {
+	if playerID == "" {
+		return "players without an id"
+	}
+	return "player " + playerID
+}
+
+// sessionKey is the store key of a session's budget
+func sessionKey(sessionID string) string {
+	return "session-" + sessionID
+}
+
+type trackedSession struct {
+	playerID string
+	lastDay  string // Last day the session was active
+}
+
+// Budget tracks spending against limits. Daily budgets reset at midnight
+// UTC. Spending is counted locally and added to the store on each Sync,
+// which also brings in what other servers spent; without a store the
+// budget only counts this server's spending.
+type Budget struct {
+	limits Limits
+	store  Store
+
+	mu       sync.Mutex
+	day      string
+	shared   map[string]models.Spending // Totals in the store at the last sync
+	inflight map[string]models.Spending // Being added to the store
+	pending  map[string]models.Spending // Not yet added to the store
+	sessions map[string]*trackedSession
+	warned   map[string]bool
+}
+
+// New creates a budget that shares its spending through store, which may be nil
+func New(limits Limits, store Store) *Budget {
+	b := &Budget{
+		limits:   limits,
+		store:    store,
+		shared:   make(map[string]models.Spending),
+		pending:  make(map[string]models.Spending),
+		sessions: make(map[string]*trackedSession),
+	}
+	b.rollover()
+	return b
+}
+
+// rollover starts a new day's budgets once the day is over. Session spending
+// carries over; sessions idle since before the last day are forgotten.
+// Callers hold mu.
+func (b *Budget) rollover() {
+	day := time.Now().UTC().Format("2006-01-02")
+	if day == b.day {
+		return
+	}
+
+	for id, s := range b.sessions {
+		if s.lastDay != b.day {
+			delete(b.sessions, id)
+		}
+	}
+	b.day = day
+	b.warned = make(map[string]bool)
+
+	// The last day's pending spending still goes to the store on the next
+	// sync; without a store it has nowhere left to go
+	for key := range b.shared {
+		if !b.current(key) {
+			delete(b.shared, key)
+		}
+	}
+	if b.store == nil {
+		for key := range b.pending {
+			if !b.current(key) {
+				delete(b.pending, key)
+			}
+		}
+	}
+}
+
+// current reports whether key is a budget of today or of a tracked session.
+// Callers hold mu.
+func (b *Budget) current(key string) bool {
+	if id, ok := strings.CutPrefix(key, sessionKey("")); ok {
+		_, tracked := b.sessions[id]
+		return tracked
+	}
+	return key == dailyKey(b.day) || strings.HasPrefix(key, playerKey(b.day, ""))
+}
+
+// spent returns the spending counted under key. Callers hold mu.
+func (b *Budget) spent(key string) models.Spending {
+	s := b.shared[key]
+	s.Add(b.inflight[key])
+	s.Add(b.pending[key])
+	return s
+}
+
+// count adds spending under key until the next sync. Callers hold mu.
+func (b *Budget) count(key string, s models.Spending) {
+	p := b.pending[key]
+	p.Add(s)
+	b.pending[key] = p
+}
+
+// AdmitSession counts a new session toward the daily session budgets, or
+// returns ErrExhausted if one has run out
+func (b *Budget) AdmitSession(ctx context.Context, playerID string) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	b.rollover()
+	var keys, scopes []string
+	var limits []int64
+	if b.limits.Daily.Sessions > 0 {
+		keys = append(keys, dailyKey(b.day))
+		scopes = append(scopes, "the daily session limit has been reached, try again tomorrow")
+		limits = append(limits, b.limits.Daily.Sessions)
+	}
+	if b.limits.PlayerDaily.Sessions > 0 {
+		keys = append(keys, playerKey(b.day, playerID))
+		scopes = append(scopes, fmt.Sprintf("the daily session limit for %s has been reached, try again tomorrow", playerName(playerID)))
+		limits = append(limits, b.limits.PlayerDaily.Sessions)
+	}
+	b.mu.Unlock()
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	full := -1
+	stored := false
+	if b.store != nil {
+		var err error
+		full, err = b.store.AdmitSession(ctx, keys, limits)
+		if err != nil {
+			log.Printf("Warning: Budget: %v, counting the session locally", err)
+		} else {
+			stored = true
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !stored {
+		// Counted locally, the session still reaches the store on the next sync
+		for i, key := range keys {
+			if over(b.spent(key).Sessions, limits[i]) {
+				full = i
+				break
+			}
+		}
+		if full < 0 {
+			for _, key := range keys {
+				b.count(key, models.Spending{Sessions: 1})
+			}
+		}
+	} else if full < 0 {
+		// The store has it; keep the local view in step until the next sync
+		for _, key := range keys {
+			s := b.shared[key]
+			s.Sessions++
+			b.shared[key] = s
+		}
+	}
+
+	if full >= 0 {
+		return fmt.Errorf("%w: %s", ErrExhausted, scopes[full])
+	}
+	return nil
+}
+
+// TrackSession tells the budget who owns a new session
+func (b *Budget) TrackSession(sessionID, playerID string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rollover()
+	b.sessions[sessionID] = &trackedSession{playerID: playerID, lastDay: b.day}
+}
+
+// Record counts usage toward the budgets covering ctx. It is meant to be
+// registered with usage.Observe.
+func (b *Budget) Record(ctx context.Context, u models.Usage) {
+	spending := models.Spending{Tokens: u.PromptTokens + u.OutputTokens, TTSCharacters: u.TTSCharacters}
+	if spending == (models.Spending{}) {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rollover()
+
+	if b.limits.Daily.capsSpending() {
+		b.count(dailyKey(b.day), spending)
+	}
+
+	sessionID := usage.SessionID(ctx)
+	s, ok := b.sessions[sessionID]
+	if !ok {
+		return
+	}
+	s.lastDay = b.day
+	if b.limits.Session.capsSpending() {
+		b.count(sessionKey(sessionID), spending)
+	}
+	if b.limits.PlayerDaily.capsSpending() {
+		b.count(playerKey(b.day, s.playerID), spending)
+	}
+}
+
+// Sync adds the spending counted since the last sync to the store and reads
+// back what every server has spent under the budgets in use today
+func (b *Budget) Sync(ctx context.Context) {
+	if b == nil || b.store == nil {
+		return
+	}
+
+	b.mu.Lock()
+	b.rollover()
+	batch := b.pending
+	b.pending = make(map[string]models.Spending)
+	b.inflight = batch
+	for _, key := range b.watched() {
+		if _, ok := batch[key]; !ok {
+			batch[key] = models.Spending{}
+		}
+	}
+	b.mu.Unlock()
+
+	totals, err := b.store.AddSpending(ctx, batch)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.inflight = nil
+
+	if err != nil {
+		// Merge back with anything recorded meanwhile
+		log.Printf("Warning: Budget: %v, retrying on the next sync", err)
+		for key, s := range batch {
+			if s != (models.Spending{}) {
+				b.count(key, s)
+			}
+		}
+		return
+	}
+
+	for key, total := range totals {
+		if b.current(key) {
+			b.shared[key] = total
+		}
+	}
+}
+
+// watched returns the keys of the budgets in use today. Callers hold mu.
+func (b *Budget) watched() []string {
+	var keys []string
+	if b.limits.Daily.capsSpending() || b.limits.Daily.Sessions > 0 {
+		keys = append(keys, dailyKey(b.day))
+	}
+
+	players := make(map[string]bool) // By key
+	for id, s := range b.sessions {
+		if s.lastDay != b.day {
+			continue
+		}
+		if b.limits.Session.capsSpending() {
+			keys = append(keys, sessionKey(id))
+		}
+		if key := playerKey(b.day, s.playerID); b.limits.PlayerDaily.capsSpending() && !players[key] {
+			players[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Run syncs the budget at once and then every interval until ctx is done,
+// then syncs once more
+func (b *Budget) Run(ctx context.Context, interval time.Duration) {
+	if b == nil || b.store == nil {
+		return
+	}
+
+	b.Sync(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			syncCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			b.Sync(syncCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			b.Sync(ctx)
+		}
+	}
+}
+
+// allow returns ErrExhausted if a budget covering ctx has no res left
+func (b *Budget) allow(ctx context.Context, res resource) error {
+	if b.limits.Daily.limit(res) == 0 && b.limits.PlayerDaily.limit(res) == 0 && b.limits.Session.limit(res) == 0 {
+		return nil
+	}
+
+	sessionID := usage.SessionID(ctx)
+	if sessionID != "" && (b.limits.PlayerDaily.limit(res) > 0 || b.limits.Session.limit(res) > 0) {
+		b.resolve(ctx, sessionID)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rollover()
+
+	scope := ""
+	switch s, ok := b.sessions[sessionID]; {
+	case over(used(b.spent(dailyKey(b.day)), res), b.limits.Daily.limit(res)):
+		scope = "daily"
+	case ok && over(used(b.spent(playerKey(b.day, s.playerID)), res), b.limits.PlayerDaily.limit(res)):
+		scope = playerName(s.playerID)
+	case ok && over(used(b.spent(sessionKey(sessionID)), res), b.limits.Session.limit(res)):
+		scope = "session " + sessionID
+	default:
+		return nil
+	}
+
+	err := fmt.Errorf("%w: %s %s budget", ErrExhausted, scope, res)
+	if key := scope + string(res); !b.warned[key] {
+		b.warned[key] = true
+		log.Printf("Warning: Budget: %v, falling back until it resets", err)
+	}
+	return err
+}
+
+// resolve looks up the owner of a session the budget hasn't seen yet, such
+// as one started before the server restarted. Its spending so far is read
+// from the store on the next sync.
+func (b *Budget) resolve(ctx context.Context, sessionID string) {
+	b.mu.Lock()
+	_, ok := b.sessions[sessionID]
+	b.mu.Unlock()
+	if ok || b.store == nil {
+		return
+	}
+
+	playerID, err := b.store.SessionOwner(ctx, sessionID)
+	if err != nil {
+		log.Printf("Warning: Budget: failed to look up owner of session %s: %v", sessionID, err)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.sessions[sessionID]; !ok {
+		b.sessions[sessionID] = &trackedSession{playerID: playerID, lastDay: b.day}
+	}
+}
+
+// over reports whether spent has reached limit; a zero limit is unlimited
+func over(spent, limit int64) bool {
+	return limit > 0 && spent >= limit
+}
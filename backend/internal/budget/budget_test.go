@@ -0,0 +1,347 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/usage"
+)
+
+// fakeStore keeps spending in memory, standing in for Firestore and for
+// the other servers writing to it
+type fakeStore struct {
+	mu     sync.Mutex
+	spent  map[string]models.Spending
+	owners map[string]string
+	err    error // Returned by every call while set
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{spent: make(map[string]models.Spending), owners: make(map[string]string)}
+}
+
+func (s *fakeStore) AddSpending(ctx context.Context, deltas map[string]models.Spending) (map[string]models.Spending, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	totals := make(map[string]models.Spending, len(deltas))
+	for key, delta := range deltas {
+		total := s.spent[key]
+		total.Add(delta)
+		s.spent[key] = total
+		totals[key] = total
+	}
+	return totals, nil
+}
+
+func (s *fakeStore) AdmitSession(ctx context.Context, keys []string, limits []int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return -1, s.err
+	}
+
+	for i, key := range keys {
+		if over(s.spent[key].Sessions, limits[i]) {
+			return i, nil
+		}
+	}
+	for _, key := range keys {
+		total := s.spent[key]
+		total.Sessions++
+		s.spent[key] = total
+	}
+	return -1, nil
+}
+
+func (s *fakeStore) SessionOwner(ctx context.Context, sessionID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.owners[sessionID], s.err
+}
+
+// add counts spending as another server would
+func (s *fakeStore) add(key string, spending models.Spending) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	total := s.spent[key]
+	total.Add(spending)
+	s.spent[key] = total
+}
+
+func (s *fakeStore) get(key string) models.Spending {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.spent[key]
+}
+
+// sessionCtx scopes ctx to a session, as handlers do
+func sessionCtx(sessionID string) context.Context {
+	return usage.NewLedger(nil).WithSession(context.Background(), sessionID)
+}
+
+func TestAdmitSession(t *testing.T) {
+	limits := Limits{Daily: Quota{Sessions: 5}, PlayerDaily: Quota{Sessions: 2}}
+	steps := []struct {
+		playerID string
+		want     string // Error text, "" if admitted
+	}{
+		{"ada", ""},
+		{"ada", ""},
+		{"ada", "player ada"},
+		{"", ""},
+		{"", ""},
+		{"", "players without an id"}, // Leaving the id out doesn't lift the limit
+		{"boris", ""},
+		{"carol", "daily session limit has been reached"},
+	}
+
+	stores := []struct {
+		name  string
+		store func() Store
+	}{
+		{"memory", func() Store { return nil }},
+		{"shared", func() Store { return newFakeStore() }},
+		{"store failing", func() Store {
+			s := newFakeStore()
+			s.err = errors.New("unavailable")
+			return s
+		}},
+	}
+	for _, tt := range stores {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(limits, tt.store())
+			for i, step := range steps {
+				err := b.AdmitSession(context.Background(), step.playerID)
+				if step.want == "" {
+					if err != nil {
+						t.Fatalf("step %d (%q): %v", i, step.playerID, err)
+					}
+					continue
+				}
+				if !errors.Is(err, ErrExhausted) || !strings.Contains(err.Error(), step.want) {
+					t.Fatalf("step %d (%q): err = %v, want %q", i, step.playerID, err, step.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAdmitSessionShared(t *testing.T) {
+	store := newFakeStore()
+	limits := Limits{PlayerDaily: Quota{Sessions: 2}}
+	first, second := New(limits, store), New(limits, store)
+
+	// Two servers draw on the same player budget
+	if err := first.AdmitSession(context.Background(), "ada"); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.AdmitSession(context.Background(), "ada"); err != nil {
+		t.Fatal(err)
+	}
+	if err := first.AdmitSession(context.Background(), "ada"); !errors.Is(err, ErrExhausted) {
+		t.Errorf("third session across servers: err = %v, want ErrExhausted", err)
+	}
+}
+
+func TestAllow(t *testing.T) {
+	limits := Limits{
+		Daily:       Quota{Tokens: 1000},
+		PlayerDaily: Quota{Tokens: 300},
+		Session:     Quota{Tokens: 200, TTSCharacters: 50},
+	}
+	type record struct {
+		session string
+		usage   models.Usage
+	}
+	tests := []struct {
+		name    string
+		records []record
+		session string   // Asking for the call
+		res     resource // Being asked for
+		want    string   // Error text, "" if allowed
+	}{
+		{"nothing spent", nil, "s1", tokens, ""},
+		{"under the session budget", []record{{"s1", models.Usage{PromptTokens: 150, OutputTokens: 49}}}, "s1", tokens, ""},
+		{"session budget spent", []record{{"s1", models.Usage{PromptTokens: 150, OutputTokens: 50}}}, "s1", tokens, "session s1"},
+		{"other session unaffected", []record{{"s1", models.Usage{PromptTokens: 200}}}, "s2", tokens, ""},
+		{"player budget across sessions", []record{{"s1", models.Usage{PromptTokens: 150}}, {"s2", models.Usage{PromptTokens: 150}}}, "s2", tokens, "player ada"},
+		{"sessions without a player share one", []record{{"s3", models.Usage{PromptTokens: 150}}, {"s4", models.Usage{PromptTokens: 150}}}, "s4", tokens, "players without an id"},
+		{"resources are separate", []record{{"s1", models.Usage{PromptTokens: 200}}}, "s1", characters, ""},
+		{"character budget", []record{{"s1", models.Usage{TTSCharacters: 50}}}, "s1", characters, "session s1 TTS character"},
+		{"daily budget", []record{{"s1", models.Usage{PromptTokens: 100}}, {"s5", models.Usage{PromptTokens: 900}}}, "s1", tokens, "daily"},
+		{"daily budget covers calls outside sessions", []record{{"", models.Usage{PromptTokens: 1000}}}, "", tokens, "daily"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(limits, nil)
+			b.TrackSession("s1", "ada")
+			b.TrackSession("s2", "ada")
+			b.TrackSession("s3", "")
+			b.TrackSession("s4", "")
+			b.TrackSession("s5", "boris")
+			for _, r := range tt.records {
+				b.Record(sessionCtx(r.session), r.usage)
+			}
+
+			err := b.allow(sessionCtx(tt.session), tt.res)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("err = %v, want allowed", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrExhausted) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowResolvesOwner(t *testing.T) {
+	// A session started before a restart is looked up in the store, and
+	// its spending so far is read back on the next sync
+	store := newFakeStore()
+	store.owners["s1"] = "ada"
+	store.add(sessionKey("s1"), models.Spending{Tokens: 200})
+
+	b := New(Limits{Session: Quota{Tokens: 200}}, store)
+	ctx := sessionCtx("s1")
+	if err := b.allow(ctx, tokens); err != nil {
+		t.Fatalf("before sync: %v", err)
+	}
+	b.Sync(context.Background())
+	if err := b.allow(ctx, tokens); !errors.Is(err, ErrExhausted) {
+		t.Errorf("after sync: err = %v, want ErrExhausted", err)
+	}
+}
+
+func TestSync(t *testing.T) {
+	store := newFakeStore()
+	limits := Limits{Daily: Quota{Tokens: 1000}, PlayerDaily: Quota{Tokens: 500}}
+	b := New(limits, store)
+	b.TrackSession("s1", "ada")
+	daily, player := dailyKey(b.day), playerKey(b.day, "ada")
+
+	b.Record(sessionCtx("s1"), models.Usage{PromptTokens: 100})
+	b.Sync(context.Background())
+	if got := store.get(daily).Tokens; got != 100 {
+		t.Fatalf("stored daily tokens = %d, want 100", got)
+	}
+
+	// Other servers' spending shows up on the next sync
+	store.add(daily, models.Spending{Tokens: 850})
+	store.add(player, models.Spending{Tokens: 400})
+	b.Sync(context.Background())
+	if err := b.allow(sessionCtx("s1"), tokens); !errors.Is(err, ErrExhausted) || !strings.Contains(err.Error(), "player ada") {
+		t.Errorf("err = %v, want the player budget spent", err)
+	}
+
+	// A failed sync keeps its spending for the next one and still counts it
+	store.err = errors.New("unavailable")
+	b.Record(sessionCtx("s1"), models.Usage{PromptTokens: 30})
+	b.Sync(context.Background())
+	b.Record(sessionCtx("s1"), models.Usage{PromptTokens: 20})
+	b.mu.Lock()
+	spent := b.spent(daily).Tokens
+	b.mu.Unlock()
+	if spent != 1000 {
+		t.Errorf("daily tokens counted while the store is down = %d, want 1000", spent)
+	}
+
+	store.err = nil
+	b.Sync(context.Background())
+	if got := store.get(daily).Tokens; got != 1000 {
+		t.Errorf("stored daily tokens after recovery = %d, want 1000", got)
+	}
+	if got := store.get(player).Tokens; got != 550 {
+		t.Errorf("stored player tokens after recovery = %d, want 550", got)
+	}
+}
+
+func TestSyncOnlyCountsCappedScopes(t *testing.T) {
+	store := newFakeStore()
+	b := New(Limits{Session: Quota{TTSCharacters: 100}}, store)
+	b.TrackSession("s1", "ada")
+
+	b.Record(sessionCtx("s1"), models.Usage{PromptTokens: 10, TTSCharacters: 20})
+	b.Sync(context.Background())
+
+	if got := store.get(sessionKey("s1")); got != (models.Spending{Tokens: 10, TTSCharacters: 20}) {
+		t.Errorf("session spending = %+v", got)
+	}
+	if got := store.get(dailyKey(b.day)); got != (models.Spending{}) {
+		t.Errorf("uncapped daily spending stored: %+v", got)
+	}
+	if got := store.get(playerKey(b.day, "ada")); got != (models.Spending{}) {
+		t.Errorf("uncapped player spending stored: %+v", got)
+	}
+}
+
+func TestRollover(t *testing.T) {
+	tests := []struct {
+		name        string
+		store       bool
+		wantPending bool // Whether yesterday's unsynced spending is kept
+	}{
+		{"memory", false, false},
+		{"shared", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var store Store
+			if tt.store {
+				store = newFakeStore()
+			}
+			b := New(Limits{Daily: Quota{Tokens: 100}, PlayerDaily: Quota{Tokens: 100}, Session: Quota{Tokens: 100}}, store)
+
+			// Pretend the budget was last used yesterday
+			const yesterday = "2000-01-01"
+			b.mu.Lock()
+			b.day = yesterday
+			b.sessions["active"] = &trackedSession{playerID: "ada", lastDay: yesterday}
+			b.sessions["idle"] = &trackedSession{playerID: "ada", lastDay: "1999-12-31"}
+			b.shared[dailyKey(yesterday)] = models.Spending{Tokens: 100}
+			b.shared[playerKey(yesterday, "ada")] = models.Spending{Tokens: 100}
+			b.shared[sessionKey("active")] = models.Spending{Tokens: 40}
+			b.shared[sessionKey("idle")] = models.Spending{Tokens: 40}
+			b.pending[dailyKey(yesterday)] = models.Spending{Tokens: 5}
+			b.rollover()
+			defer b.mu.Unlock()
+
+			if b.day == yesterday {
+				t.Fatal("day didn't roll over")
+			}
+			if _, ok := b.sessions["idle"]; ok {
+				t.Error("session idle since before yesterday kept")
+			}
+			if _, ok := b.sessions["active"]; !ok {
+				t.Error("session active yesterday forgotten")
+			}
+
+			// Daily budgets start afresh; session budgets carry over
+			if got := b.spent(dailyKey(b.day)).Tokens; got != 0 {
+				t.Errorf("today's daily tokens = %d, want 0", got)
+			}
+			if got := b.spent(playerKey(b.day, "ada")).Tokens; got != 0 {
+				t.Errorf("today's player tokens = %d, want 0", got)
+			}
+			if got := b.spent(sessionKey("active")).Tokens; got != 40 {
+				t.Errorf("active session tokens = %d, want 40", got)
+			}
+			if _, ok := b.shared[sessionKey("idle")]; ok {
+				t.Error("idle session's spending kept")
+			}
+			if _, ok := b.pending[dailyKey(yesterday)]; ok != tt.wantPending {
+				t.Errorf("yesterday's pending spending kept = %v, want %v", ok, tt.wantPending)
+			}
+		})
+	}
+}
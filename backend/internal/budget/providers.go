@@ -0,0 +1,103 @@
+package budget
+
+import (
+	"context"
+
+	"github.com/ttrubel/send-me-home/internal/services/llm"
+	"github.com/ttrubel/send-me-home/internal/services/stt"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+)
+
+// Completer wraps a completer so that it is unavailable while a token budget
+// covering the call has run out, which makes the generator use mock content
+func (b *Budget) Completer(c llm.Completer) llm.Completer {
+	if b == nil || c == nil {
+		return c
+	}
+	return &budgetedCompleter{Completer: c, budget: b}
+}
+
+type budgetedCompleter struct {
+	llm.Completer
+	budget *Budget
+}
+
+func (c *budgetedCompleter) Available(ctx context.Context) bool {
+	return c.budget.allow(ctx, tokens) == nil && c.Completer.Available(ctx)
+}
+
+func (c *budgetedCompleter) Complete(ctx context.Context, prompt string, temperature float32) (string, error) {
+	if err := c.budget.allow(ctx, tokens); err != nil {
+		return "", err
+	}
+	return c.Completer.Complete(ctx, prompt, temperature)
+}
+
+// Synthesizer wraps a synthesizer so that it fails with ErrExhausted while a
+// TTS character budget covering the call has run out; callers then carry on
+// with text only
+func (b *Budget) Synthesizer(s tts.SpeechSynthesizer) tts.SpeechSynthesizer {
+	if b == nil {
+		return s
+	}
+	return &budgetedSynthesizer{synth: s, budget: b}
+}
+
+type budgetedSynthesizer struct {
+	synth  tts.SpeechSynthesizer
+	budget *Budget
+}
+
+func (s *budgetedSynthesizer) Synthesize(ctx context.Context, voice, text string, emotion tts.Emotion, format tts.Format) (*tts.Speech, error) {
+	if err := s.budget.allow(ctx, characters); err != nil {
+		return nil, err
+	}
+	return s.synth.Synthesize(ctx, voice, text, emotion, format)
+}
+
+func (s *budgetedSynthesizer) SynthesizeTimed(ctx context.Context, voice, text string, emotion tts.Emotion, format tts.Format) (*tts.Speech, error) {
+	if err := s.budget.allow(ctx, characters); err != nil {
+		return nil, err
+	}
+	return s.synth.SynthesizeTimed(ctx, voice, text, emotion, format)
+}
+
+func (s *budgetedSynthesizer) SynthesizeStream(ctx context.Context, voice, text string, emotion tts.Emotion, format tts.Format) (*tts.Stream, error) {
+	if err := s.budget.allow(ctx, characters); err != nil {
+		return nil, err
+	}
+	return s.synth.SynthesizeStream(ctx, voice, text, emotion, format)
+}
+
+// Transcriber wraps the configured STT provider's transcriber so that it
+// fails with ErrExhausted while a budget covering the call has run out.
+// Gemini transcriptions are billed in tokens; ElevenLabs ones come out of the
+// same credits as speech, so they stop with the TTS character budget. Local
+// transcription costs nothing and isn't wrapped.
+func (b *Budget) Transcriber(t stt.Transcriber, provider string) stt.Transcriber {
+	if b == nil {
+		return t
+	}
+
+	switch provider {
+	case "local":
+		return t
+	case "gemini":
+		return &budgetedTranscriber{transcriber: t, budget: b, res: tokens}
+	default:
+		return &budgetedTranscriber{transcriber: t, budget: b, res: characters}
+	}
+}
+
+type budgetedTranscriber struct {
+	transcriber stt.Transcriber
+	budget      *Budget
+	res         resource
+}
+
+func (t *budgetedTranscriber) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	if err := t.budget.allow(ctx, t.res); err != nil {
+		return "", err
+	}
+	return t.transcriber.Transcribe(ctx, audio, mimeType)
+}
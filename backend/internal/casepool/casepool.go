@@ -0,0 +1,175 @@
+// Package casepool pre-generates validated cases with audio so sessions can
+// reuse them instead of calling Gemini for every game.
+package casepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/services/firestore"
+	"github.com/ttrubel/send-me-home/internal/services/llm"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+)
+
+// batchSize is how many cases are requested from Gemini at once
+const batchSize = 5
+
+// Difficulties lists every difficulty the pool keeps cases for
+var Difficulties = []models.Difficulty{models.DifficultyEasy, models.DifficultyNormal, models.DifficultyHard}
+
+// ErrGenerationUnavailable is returned when Gemini falls back to placeholder cases
+var ErrGenerationUnavailable = errors.New("case generation unavailable")
+
+// Validate checks that a generated case is complete and self-consistent
+func Validate(c models.Case) error {
+	if c.Mock {
+		return fmt.Errorf("placeholder case")
+	}
+	if c.NPC.Name == "" || c.OpeningLine == "" {
+		return fmt.Errorf("missing npc name or opening line")
+	}
+	if c.CorrectDecision != "approve" && c.CorrectDecision != "deny" {
+		return fmt.Errorf("invalid correct decision: %q", c.CorrectDecision)
+	}
+	if c.Truth.ShouldApprove != (c.CorrectDecision == "approve") {
+		return fmt.Errorf("truth contradicts correct decision")
+	}
+
+	docs := map[string]bool{}
+	for _, doc := range c.Documents {
+		if doc.Fields["name"] != c.NPC.Name {
+			return fmt.Errorf("%s name does not match npc", doc.Type)
+		}
+		docs[doc.Type] = true
+	}
+	if !docs["employee_badge"] || !docs["clearance_form"] {
+		return fmt.Errorf("missing documents")
+	}
+
+	return nil
+}
+
+// Filler generates cases into the pool
+type Filler struct {
+	content   llm.ContentGenerator
+	speech    tts.SpeechSynthesizer
+	firestore *firestore.Client
+}
+
+func NewFiller(content llm.ContentGenerator, speech tts.SpeechSynthesizer, firestoreClient *firestore.Client) *Filler {
+	return &Filler{
+		content:   content,
+		speech:    speech,
+		firestore: firestoreClient,
+	}
+}
+
+// Fill tops up the pool to ruleSets rule sets with at least count cases
+// per difficulty each
+func (f *Filler) Fill(ctx context.Context, ruleSets, count int, difficulties []models.Difficulty) error {
+	existing, err := f.firestore.ListPoolRuleSets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, ruleSet := range existing {
+		for _, difficulty := range difficulties {
+			missing := count - ruleSet.CaseCounts[string(difficulty)]
+			if missing <= 0 {
+				continue
+			}
+			if err := f.fillCases(ctx, ruleSet.Key, ruleSet.Rules, ruleSet.GameDate, difficulty, missing); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(existing); i < ruleSets; i++ {
+		gameDate := time.Now().AddDate(100, 0, 0).Format("2006-01-02")
+		rules, err := f.content.GenerateRules(ctx, gameDate, nil)
+		if err != nil {
+			return fmt.Errorf("failed to generate rules: %w", err)
+		}
+
+		for _, difficulty := range difficulties {
+			if err := f.fillCases(ctx, models.RuleSetKey(rules), rules, gameDate, difficulty, count); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Run fills the pool every interval until ctx is done
+func (f *Filler) Run(ctx context.Context, interval time.Duration, ruleSets, count int, difficulties []models.Difficulty) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.Fill(ctx, ruleSets, count, difficulties); err != nil {
+			log.Printf("Warning: Failed to fill case pool: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fillCases generates, validates and voices count cases and adds them to the pool
+func (f *Filler) fillCases(ctx context.Context, key string, rules []string, gameDate string, difficulty models.Difficulty, count int) error {
+	for added := 0; added < count; {
+		cases, err := f.content.GenerateCases(ctx, models.CaseRequest{
+			Rules:      rules,
+			GameDate:   gameDate,
+			Count:      min(batchSize, count-added),
+			Difficulty: difficulty,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to generate cases: %w", err)
+		}
+
+		valid := make([]models.Case, 0, len(cases))
+		for _, caseData := range cases {
+			if caseData.Mock {
+				return ErrGenerationUnavailable
+			}
+			if err := Validate(caseData); err != nil {
+				log.Printf("Case pool: discarding %s: %v", caseData.CaseID, err)
+				continue
+			}
+
+			speech, err := f.speech.SynthesizeTimed(lexicon.WithPronunciations(ctx, caseData.NPC.Pronunciations), caseData.NPC.VoiceID, caseData.OpeningLine, tts.EmotionNeutral, tts.DefaultFormat)
+			if err != nil {
+				log.Printf("Case pool: discarding %s: failed to generate audio: %v", caseData.CaseID, err)
+				continue
+			}
+			if speech != nil {
+				caseData.OpeningAudio = speech.Audio
+				caseData.OpeningAudioFormat = string(speech.Format)
+				caseData.OpeningSubtitles = speech.Words
+			}
+			valid = append(valid, caseData)
+		}
+
+		if len(valid) == 0 {
+			return fmt.Errorf("no valid cases generated")
+		}
+		if err := f.firestore.AddPooledCases(ctx, key, rules, gameDate, difficulty, valid); err != nil {
+			return err
+		}
+
+		added += len(valid)
+		log.Printf("Case pool: added %d %s cases (%d/%d)", len(valid), difficulty, added, count)
+	}
+
+	return nil
+}
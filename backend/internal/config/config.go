@@ -2,15 +2,77 @@ package config
 
 import (
 	"log"
+	"math"
+	"math/rand/v2"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Port             string
-	ElevenLabsAPIKey string
-	GCPProjectID     string
+	Port              string
+	ElevenLabsAPIKey  string
+	GCPProjectID      string
+	ShiftLength       time.Duration // Real time until the shuttle departs; 0 disables the shift clock
+	ScoringStrategies []string      // Scoring strategies applied to each decision, in order
+
+	// Content generation
+	ContentMode   string // "live" or "mock"; mock makes all content offline from MockSeed
+	MockSeed      int    // Seed for mock content; random unless MOCK_SEED is set
+	LLMProvider   string // "gemini" or "openai"
+	OpenAIBaseURL string // OpenAI-compatible endpoint, e.g. a local llama.cpp or Ollama server
+	OpenAIAPIKey  string
+	OpenAIModel   string
+
+	// Speech
+	TTSProvider     string // "elevenlabs" or "local"
+	ElevenLabsModel string // ElevenLabs text-to-speech model; emotion profiles can be tuned per model
+	TTSCommand      string // Offline TTS command for the local provider; empty uses placeholder audio
+	STTProvider     string // "elevenlabs", "gemini" or "local"
+	STTCommand      string // Offline STT command for the local provider; empty echoes text audio
+
+	// Voices
+	VoicesFile           string        // JSON voice catalog; empty uses the built-in catalog
+	VoicesReloadInterval time.Duration // How often the voices file is checked for changes; 0 disables reloading
+	LexiconFile          string        // JSON pronunciation lexicon; empty uses the built-in lexicon
+
+	AdminToken string // Bearer token for admin RPCs; empty disables them
+
+	// Usage accounting
+	UsageFlushInterval time.Duration // How often session and player usage is written to Firestore
+	PromptTokenPrice   float64       // USD per million LLM prompt tokens
+	OutputTokenPrice   float64       // USD per million LLM output tokens
+	TTSCharacterPrice  float64       // USD per thousand TTS characters
+
+	// Resilience of AI provider calls
+	RetryAttempts           int           // Tries per call, including the first
+	RetryBaseDelay          time.Duration // Backoff before the first retry; doubles on each retry
+	RetryMaxDelay           time.Duration // Cap on the backoff
+	LLMCallTimeout          time.Duration // Per attempt of an LLM call
+	SpeechCallTimeout       time.Duration // Per attempt of a TTS or STT call
+	CircuitBreakerThreshold int           // Consecutive failures that open a provider's circuit; 0 disables breaking
+	CircuitBreakerCooldown  time.Duration // How long an open circuit refuses calls before a trial call
+
+	// Budgets; 0 means unlimited. Daily budgets reset at midnight UTC.
+	DailyTokenBudget              int // LLM tokens per day across all players
+	DailyTTSCharacterBudget       int // TTS characters per day across all players
+	DailySessionBudget            int // Sessions started per day across all players
+	PlayerDailyTokenBudget        int // LLM tokens per player per day
+	PlayerDailyTTSCharacterBudget int // TTS characters per player per day
+	PlayerDailySessionBudget      int // Sessions a player can start per day
+	SessionTokenBudget            int // LLM tokens per session
+	SessionTTSCharacterBudget     int // TTS characters per session
+
+	BudgetSyncInterval time.Duration // How often spending is shared with other servers through Firestore
+
+	// Case pool
+	CasePool             bool          // Reuse pre-generated cases across sessions
+	CasePoolFillInterval time.Duration // How often the server tops up the pool; 0 disables the worker
+	CasePoolRuleSets     int           // Rule sets the worker keeps in the pool
+	CasePoolSize         int           // Cases per rule set and difficulty
 }
 
 func Load() *Config {
@@ -19,10 +81,67 @@ func Load() *Config {
 		log.Printf("No .env file found, using environment variables")
 	}
 
+	// Mock content is reproducible from its seed, which pooled cases would break
+	contentMode := getEnv("CONTENT_MODE", "live")
+	mockSeed := getIntEnv("MOCK_SEED", 0)
+	if mockSeed == 0 {
+		mockSeed = 1 + rand.IntN(math.MaxInt32)
+	}
+
 	return &Config{
-		Port:             getEnv("PORT", "8080"),
-		ElevenLabsAPIKey: getEnv("ELEVENLABS_API_KEY", ""),
-		GCPProjectID:     getEnv("GOOGLE_CLOUD_PROJECT", ""),
+		Port:              getEnv("PORT", "8080"),
+		ElevenLabsAPIKey:  getEnv("ELEVENLABS_API_KEY", ""),
+		GCPProjectID:      getEnv("GOOGLE_CLOUD_PROJECT", ""),
+		ShiftLength:       getDurationEnv("SHIFT_LENGTH", 15*time.Minute),
+		ScoringStrategies: strings.Split(getEnv("SCORING_STRATEGIES", "outcome,streak,speed,citations,checks,flags"), ","),
+
+		ContentMode:   contentMode,
+		MockSeed:      mockSeed,
+		LLMProvider:   getEnv("LLM_PROVIDER", "gemini"),
+		OpenAIBaseURL: getEnv("OPENAI_BASE_URL", ""),
+		OpenAIAPIKey:  getEnv("OPENAI_API_KEY", ""),
+		OpenAIModel:   getEnv("OPENAI_MODEL", "llama3.1"),
+
+		TTSProvider:     getEnv("TTS_PROVIDER", "elevenlabs"),
+		ElevenLabsModel: getEnv("ELEVENLABS_MODEL", "eleven_turbo_v2_5"),
+		TTSCommand:      getEnv("TTS_COMMAND", ""),
+		STTProvider:     getEnv("STT_PROVIDER", "elevenlabs"),
+		STTCommand:      getEnv("STT_COMMAND", ""),
+
+		VoicesFile:           getEnv("VOICES_FILE", ""),
+		VoicesReloadInterval: getDurationEnv("VOICES_RELOAD_INTERVAL", 30*time.Second),
+		LexiconFile:          getEnv("LEXICON_FILE", ""),
+
+		AdminToken: getEnv("ADMIN_TOKEN", ""),
+
+		UsageFlushInterval: getDurationEnv("USAGE_FLUSH_INTERVAL", 10*time.Second),
+		PromptTokenPrice:   getFloatEnv("PROMPT_TOKEN_PRICE", 0.10),
+		OutputTokenPrice:   getFloatEnv("OUTPUT_TOKEN_PRICE", 0.40),
+		TTSCharacterPrice:  getFloatEnv("TTS_CHARACTER_PRICE", 0.30),
+
+		RetryAttempts:           getIntEnv("AI_RETRY_ATTEMPTS", 3),
+		RetryBaseDelay:          getDurationEnv("AI_RETRY_BASE_DELAY", 500*time.Millisecond),
+		RetryMaxDelay:           getDurationEnv("AI_RETRY_MAX_DELAY", 5*time.Second),
+		LLMCallTimeout:          getDurationEnv("LLM_CALL_TIMEOUT", 90*time.Second),
+		SpeechCallTimeout:       getDurationEnv("SPEECH_CALL_TIMEOUT", 30*time.Second),
+		CircuitBreakerThreshold: getIntEnv("CIRCUIT_BREAKER_THRESHOLD", 5),
+		CircuitBreakerCooldown:  getDurationEnv("CIRCUIT_BREAKER_COOLDOWN", 30*time.Second),
+
+		DailyTokenBudget:              getIntEnv("BUDGET_DAILY_TOKENS", 0),
+		DailyTTSCharacterBudget:       getIntEnv("BUDGET_DAILY_TTS_CHARACTERS", 0),
+		DailySessionBudget:            getIntEnv("BUDGET_DAILY_SESSIONS", 0),
+		PlayerDailyTokenBudget:        getIntEnv("BUDGET_PLAYER_DAILY_TOKENS", 0),
+		PlayerDailyTTSCharacterBudget: getIntEnv("BUDGET_PLAYER_DAILY_TTS_CHARACTERS", 0),
+		PlayerDailySessionBudget:      getIntEnv("BUDGET_PLAYER_DAILY_SESSIONS", 0),
+		SessionTokenBudget:            getIntEnv("BUDGET_SESSION_TOKENS", 0),
+		SessionTTSCharacterBudget:     getIntEnv("BUDGET_SESSION_TTS_CHARACTERS", 0),
+
+		BudgetSyncInterval: getDurationEnv("BUDGET_SYNC_INTERVAL", 10*time.Second),
+
+		CasePool:             getBoolEnv("CASE_POOL", true) && contentMode != "mock",
+		CasePoolFillInterval: getDurationEnv("CASE_POOL_FILL_INTERVAL", 0),
+		CasePoolRuleSets:     getIntEnv("CASE_POOL_RULE_SETS", 5),
+		CasePoolSize:         getIntEnv("CASE_POOL_SIZE", 15),
 	}
 }
 
@@ -32,3 +151,59 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid %s %q, using %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return duration
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Invalid %s %q, using %t", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid %s %q, using %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+func getFloatEnv(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("Invalid %s %q, using %g", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
@@ -0,0 +1,209 @@
+// Package lexicon fixes how the speech engine pronounces names and terms
+package lexicon
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// defaultLexicon covers the game's own sci-fi terms, used when no lexicon
+// file is configured
+//
+//go:embed default_lexicon.json
+var defaultLexicon []byte
+
+// file is the format of a lexicon file
+type file struct {
+	Entries []models.Pronunciation `json:"entries"`
+}
+
+// Lexicon is a deployment's pronunciation dictionary
+type Lexicon struct {
+	entries []models.Pronunciation
+}
+
+// Load reads a lexicon file, or the built-in lexicon when path is empty
+func Load(path string) (*Lexicon, error) {
+	data := defaultLexicon
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read lexicon file: %w", err)
+		}
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid lexicon file %s: %w", path, err)
+	}
+	for _, entry := range f.Entries {
+		if err := validate(entry); err != nil {
+			return nil, fmt.Errorf("invalid lexicon file %s: %w", path, err)
+		}
+	}
+
+	return &Lexicon{entries: f.Entries}, nil
+}
+
+// Len returns the number of entries in the lexicon
+func (l *Lexicon) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.entries)
+}
+
+// validate checks that an entry says how to pronounce its term
+func validate(entry models.Pronunciation) error {
+	if strings.TrimSpace(entry.Term) == "" {
+		return fmt.Errorf("entry has no term")
+	}
+	if entry.Alias == "" && entry.Phoneme == "" {
+		return fmt.Errorf("entry %q has neither an alias nor a phoneme", entry.Term)
+	}
+	switch entry.Alphabet {
+	case "", "ipa", "cmu-arpabet":
+	default:
+		return fmt.Errorf("entry %q has unknown alphabet %q", entry.Term, entry.Alphabet)
+	}
+	return nil
+}
+
+// Substitution is a term that Rewrite replaced
+type Substitution struct {
+	Start, End int    // Byte range of the term in the original text
+	Spoken     string // What the term was replaced with
+	Plain      string // Spoken without markup: the alias, or the term itself for a phoneme tag
+}
+
+// Apply rewrites text so the speech engine says every known term right.
+// Extra entries, such as a case's NPC names, take precedence over the
+// lexicon's. With phonemes set, phoneme entries become SSML phoneme tags;
+// otherwise their alias is spoken, or the term is left alone if there is none.
+func (l *Lexicon) Apply(text string, extra []models.Pronunciation, phonemes bool) string {
+	spoken, _ := l.Rewrite(text, extra, phonemes)
+	return spoken
+}
+
+// Rewrite is Apply that also returns the substitutions it made, in order,
+// so that timings of the spoken text can be mapped back onto text
+func (l *Lexicon) Rewrite(text string, extra []models.Pronunciation, phonemes bool) (string, []Substitution) {
+	byTerm := make(map[string]models.Pronunciation)
+	var terms []string
+	add := func(entries []models.Pronunciation) {
+		for _, entry := range entries {
+			key := strings.ToLower(strings.TrimSpace(entry.Term))
+			if _, ok := byTerm[key]; ok || validate(entry) != nil {
+				continue
+			}
+			if entry.Alias == "" && !phonemes {
+				continue // Nothing this engine can use
+			}
+			byTerm[key] = entry
+			terms = append(terms, key)
+		}
+	}
+	add(extra)
+	if l != nil {
+		add(l.entries)
+	}
+	if len(terms) == 0 {
+		return text, nil
+	}
+
+	// Prefer the longest term where several start at the same place
+	sort.Slice(terms, func(i, j int) bool { return len(terms[i]) > len(terms[j]) })
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	pattern := regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+
+	var b strings.Builder
+	var subs []Substitution
+	last := 0
+	for _, match := range pattern.FindAllStringIndex(text, -1) {
+		start, end := match[0], match[1]
+		if !isBoundary(text, start, end) {
+			continue
+		}
+
+		term := text[start:end]
+		entry := byTerm[strings.ToLower(term)]
+		sub := Substitution{Start: start, End: end, Spoken: render(entry, term, phonemes), Plain: entry.Alias}
+		if sub.Spoken != entry.Alias {
+			sub.Plain = term
+		}
+		subs = append(subs, sub)
+
+		b.WriteString(text[last:start])
+		b.WriteString(sub.Spoken)
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String(), subs
+}
+
+// render returns what to send the speech engine in place of term
+func render(entry models.Pronunciation, term string, phonemes bool) string {
+	if !phonemes || entry.Phoneme == "" {
+		return entry.Alias
+	}
+
+	alphabet := entry.Alphabet
+	if alphabet == "" {
+		alphabet = "ipa"
+	}
+	return fmt.Sprintf(`<phoneme alphabet="%s" ph="%s">%s</phoneme>`, alphabet, html.EscapeString(entry.Phoneme), html.EscapeString(term))
+}
+
+// isBoundary reports whether text[start:end] is a whole word rather than
+// part of a longer one
+func isBoundary(text string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if isWordRune(r) {
+			return false
+		}
+	}
+	if end < len(text) {
+		r, _ := utf8.DecodeRuneInString(text[end:])
+		if isWordRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type contextKey struct{}
+
+// WithPronunciations returns a context that carries extra entries, such as
+// a case's NPC names, to Apply calls made while synthesizing for it
+func WithPronunciations(ctx context.Context, entries []models.Pronunciation) context.Context {
+	if len(entries) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, entries)
+}
+
+// Pronunciations returns the extra entries carried by ctx
+func Pronunciations(ctx context.Context) []models.Pronunciation {
+	entries, _ := ctx.Value(contextKey{}).([]models.Pronunciation)
+	return entries
+}
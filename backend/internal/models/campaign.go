@@ -0,0 +1,30 @@
+package models
+
+// Campaign tracks a player's progress through consecutive shifts
+type Campaign struct {
+	PlayerID string        `json:"player_id"`
+	Day      int           `json:"day"`       // Last started day (1-based)
+	GameDate string        `json:"game_date"` // Game date of the last started day
+	Rules    []string      `json:"rules"`     // Rules in force on the last started day
+	Days     []CampaignDay `json:"days"`
+}
+
+// CampaignDay records one shift of a campaign
+type CampaignDay struct {
+	Day        int      `json:"day"`
+	GameDate   string   `json:"game_date"`
+	Rules      []string `json:"rules"`
+	StoryEvent string   `json:"story_event"`
+	SessionID  string   `json:"session_id"`
+}
+
+// Storyline returns the story events so far, oldest first
+func (c *Campaign) Storyline() []string {
+	events := make([]string, 0, len(c.Days))
+	for _, day := range c.Days {
+		if day.StoryEvent != "" {
+			events = append(events, day.StoryEvent)
+		}
+	}
+	return events
+}
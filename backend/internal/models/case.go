@@ -1,19 +1,62 @@
 package models
 
+import "time"
+
 // Case represents a pre-generated NPC case
 type Case struct {
-	CaseID       string                 `json:"case_id"`
-	NPC          NPCProfile             `json:"npc"`
-	Documents    []Document             `json:"documents"`
-	OpeningLine  string                 `json:"opening_line"`
-	OpeningAudio []byte                 `json:"opening_audio,omitempty"`
-	Truth        CaseTruth              `json:"truth"`
-	Contradictions []string             `json:"contradictions"`
-	CorrectDecision string              `json:"correct_decision"` // "approve" or "deny"
+	CaseID              string         `json:"case_id"`
+	NPC                 NPCProfile     `json:"npc"`
+	Documents           []Document     `json:"documents"`
+	OpeningLine         string         `json:"opening_line"`
+	OpeningAudio        []byte         `json:"opening_audio,omitempty"`
+	OpeningSubtitles    []SubtitleWord `json:"opening_subtitles,omitempty"`    // Word timings for OpeningAudio
+	OpeningAudioFormat  string         `json:"opening_audio_format,omitempty"` // Encoding of OpeningAudio, e.g. "mp3_44100_128"
+	Truth               CaseTruth      `json:"truth"`
+	Contradictions      []string       `json:"contradictions"`
+	CorrectDecision     string         `json:"correct_decision"` // "approve" or "deny"
+	Difficulty          Difficulty     `json:"difficulty,omitempty"`
+	ServedAt            time.Time      `json:"served_at,omitempty"`             // First time GetNextCase returned the case
+	ResolvedAt          time.Time      `json:"resolved_at,omitempty"`           // When the player made a decision
+	Violations          []Violation    `json:"violations,omitempty"`            // Document fields that break the rules
+	SecondaryChecksUsed int            `json:"secondary_checks_used,omitempty"` // Secondary checks spent on this case
+	Transcript          []DialogueLine `json:"transcript,omitempty"`            // Questions the player asked and the answers
+	Decision            string         `json:"decision,omitempty"`              // Player's decision, empty until resolved
+	FlaggedFields       []Violation    `json:"flagged_fields,omitempty"`        // Fields the player flagged when deciding
+	Mock                bool           `json:"mock,omitempty"`                  // Placeholder content used when generation failed
+}
+
+// Violation points at a document field that breaks one of the day's rules
+type Violation struct {
+	Document string `json:"document"` // "employee_badge" or "clearance_form"
+	Field    string `json:"field"`    // e.g. "cargo1", "expire_date"
+	Rule     string `json:"rule"`     // The rule that is broken
+}
+
+// Difficulty controls how obvious the rule violations in a case are
+type Difficulty string
+
+const (
+	DifficultyEasy   Difficulty = "easy"   // Blatant violations for struggling players
+	DifficultyNormal Difficulty = "normal" // Default mix
+	DifficultyHard   Difficulty = "hard"   // Subtle forgeries for strong players
+)
+
+// CaseRequest describes a batch of cases to generate
+type CaseRequest struct {
+	Rules      []string   `json:"rules"`
+	GameDate   string     `json:"game_date"`
+	Count      int        `json:"count"`
+	StartIndex int        `json:"start_index"` // Number of cases already in the session
+	Difficulty Difficulty `json:"difficulty"`
+
+	// Campaign continuity (optional)
+	Storyline     []string     `json:"storyline,omitempty"`      // Story events so far, oldest first
+	ReturningNPCs []NPCProfile `json:"returning_npcs,omitempty"` // Workers to bring back
 }
 
 // NPCProfile contains NPC personality and appearance
 type NPCProfile struct {
+	NPCID       string `json:"npc_id,omitempty"` // Roster ID for recurring workers
 	Name        string `json:"name"`
 	Role        string `json:"role"`
 	Department  string `json:"department"`
@@ -21,6 +64,14 @@ type NPCProfile struct {
 	VoiceID     string `json:"voice_id"`
 	Demeanor    string `json:"demeanor"` // "evasive", "cooperative", "frustrated"
 	PortraitURL string `json:"portrait_url,omitempty"`
+
+	Casting        VoiceCasting    `json:"casting"`                  // How the worker should sound
+	Pronunciations []Pronunciation `json:"pronunciations,omitempty"` // How to say the worker's name and other terms
+
+	PortraitSeed       string   `json:"portrait_seed,omitempty"`
+	Backstory          string   `json:"backstory,omitempty"`
+	History            []string `json:"history,omitempty"` // Past encounters with the clerk, oldest first
+	PreviousEncounters int      `json:"previous_encounters,omitempty"`
 }
 
 // Document represents a game document
@@ -43,23 +94,89 @@ type CaseTruth struct {
 
 // Session represents a game session
 type Session struct {
-	SessionID              string   `json:"session_id"`
-	GameDate               string   `json:"game_date"` // Current game date (e.g. "2084-12-25")
-	Rules                  []string `json:"rules"`
-	Cases                  []Case   `json:"cases"`
-	CurrentCaseIndex       int      `json:"current_case_index"`
-	Score                  int      `json:"score"`
-	CorrectDecisions       int      `json:"correct_decisions"`
-	IncorrectDecisions     int      `json:"incorrect_decisions"`
-	SecondaryChecksQuota   int      `json:"secondary_checks_quota"`
-	RemainingSecondaryChecks int    `json:"remaining_secondary_checks"`
-	CompletedCases         []string `json:"completed_cases"`
+	SessionID                string        `json:"session_id"`
+	GameDate                 string        `json:"game_date"` // Current game date (e.g. "2084-12-25")
+	Rules                    []string      `json:"rules"`
+	Cases                    []Case        `json:"cases"`
+	TotalCases               int           `json:"total_cases"` // May exceed len(Cases) while cases are generated lazily
+	Adaptive                 bool          `json:"adaptive"`
+	Pipeline                 bool          `json:"pipeline"`           // Cases are generated in the background ahead of the player
+	PoolKey                  string        `json:"pool_key,omitempty"` // Case pool rule set the session draws from; empty for campaign shifts
+	PlayerID                 string        `json:"player_id,omitempty"`
+	CampaignDay              int           `json:"campaign_day,omitempty"` // 0 for standalone shifts
+	Storyline                []string      `json:"storyline,omitempty"`    // Campaign story events up to today
+	ShiftStartedAt           time.Time     `json:"shift_started_at"`       // When the session was created
+	ShiftLength              time.Duration `json:"shift_length"`           // 0 when the shift clock is disabled
+	CurrentCaseIndex         int           `json:"current_case_index"`
+	Score                    int           `json:"score"`
+	CorrectDecisions         int           `json:"correct_decisions"`
+	IncorrectDecisions       int           `json:"incorrect_decisions"`
+	Streak                   int           `json:"streak"`    // Consecutive correct decisions
+	Citations                int           `json:"citations"` // Wrong decisions this shift
+	SecondaryChecksQuota     int           `json:"secondary_checks_quota"`
+	RemainingSecondaryChecks int           `json:"remaining_secondary_checks"`
+	CompletedCases           []string      `json:"completed_cases"`
+	Resolutions              []Resolution  `json:"resolutions"` // One per decided case, in decision order
+}
+
+// CaseCount returns the number of cases planned for the session
+func (s *Session) CaseCount() int {
+	if s.TotalCases > len(s.Cases) {
+		return s.TotalCases
+	}
+	return len(s.Cases)
+}
+
+// ClockStartedAt returns when the shift clock started, which is when the
+// first case was served. It is zero until then, so time spent generating
+// and loading the shift isn't taken from the player.
+func (s *Session) ClockStartedAt() time.Time {
+	if len(s.Cases) == 0 {
+		return time.Time{}
+	}
+	return s.Cases[0].ServedAt
+}
+
+// DialogueLine is one question the player asked and the NPC's answer
+type DialogueLine struct {
+	Question string    `json:"question"`
+	Answer   string    `json:"answer"`
+	Emotion  string    `json:"emotion,omitempty"` // How the answer was voiced
+	AskedAt  time.Time `json:"asked_at"`
+}
+
+// Questions returns the questions the player asked about the case
+func (c *Case) Questions() []string {
+	questions := make([]string, len(c.Transcript))
+	for i, line := range c.Transcript {
+		questions[i] = line.Question
+	}
+	return questions
+}
+
+// StoryEvent returns today's campaign story event, or "" for standalone shifts
+func (s *Session) StoryEvent() string {
+	if s.CampaignDay == 0 || len(s.Storyline) == 0 {
+		return ""
+	}
+	return s.Storyline[len(s.Storyline)-1]
+}
+
+// Complete reports whether every case has been decided or the shuttle has left
+func (s *Session) Complete(departed bool) bool {
+	return s.CurrentCaseIndex >= s.CaseCount() || departed
 }
 
 // DialogueContext holds context for generating NPC responses
 type DialogueContext struct {
-	Question    string     `json:"question"`
-	CaseTruth   CaseTruth  `json:"case_truth"`
-	NPCProfile  NPCProfile `json:"npc_profile"`
-	AskedQuestions []string `json:"asked_questions"`
+	Question       string     `json:"question"`
+	CaseTruth      CaseTruth  `json:"case_truth"`
+	NPCProfile     NPCProfile `json:"npc_profile"`
+	AskedQuestions []string   `json:"asked_questions"`
+}
+
+// DialogueReply is an NPC's answer with the emotion to voice it with
+type DialogueReply struct {
+	Text    string `json:"text"`
+	Emotion string `json:"emotion"`
 }
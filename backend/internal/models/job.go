@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// JobStatus is the state of a background session generation job
+type JobStatus string
+
+const (
+	JobRunning   JobStatus = "running"
+	JobReady     JobStatus = "ready" // Session generated and saved
+	JobFailed    JobStatus = "failed"
+	JobCancelled JobStatus = "cancelled"
+)
+
+// GenerationJob tracks a session being generated in the background
+type GenerationJob struct {
+	JobID    string `json:"job_id"`
+	PlayerID string `json:"player_id,omitempty"`
+
+	// Requested session
+	NumCases int  `json:"num_cases"`
+	Adaptive bool `json:"adaptive"`
+	Campaign bool `json:"campaign"`
+	Pipeline bool `json:"pipeline"`
+
+	Status    JobStatus `json:"status"`
+	Current   int       `json:"current"`
+	Total     int       `json:"total"`
+	Message   string    `json:"message"`              // Latest progress message
+	Error     string    `json:"error,omitempty"`      // Set when the job failed
+	SessionID string    `json:"session_id,omitempty"` // Set when the job is ready
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Finished reports whether the job has stopped running
+func (j *GenerationJob) Finished() bool {
+	return j.Status != JobRunning
+}
@@ -0,0 +1,82 @@
+package models
+
+import "fmt"
+
+// RosterNPC is a recurring worker with a stable identity across sessions
+type RosterNPC struct {
+	NPCID          string          `json:"npc_id"`
+	PlayerID       string          `json:"player_id"`
+	Name           string          `json:"name"`
+	Role           string          `json:"role"`
+	Department     string          `json:"department"`
+	Personality    string          `json:"personality"`
+	Demeanor       string          `json:"demeanor"`
+	VoiceID        string          `json:"voice_id"`
+	Casting        VoiceCasting    `json:"casting"`
+	Pronunciations []Pronunciation `json:"pronunciations,omitempty"`
+	PortraitSeed   string          `json:"portrait_seed"`
+	Backstory      string          `json:"backstory"`
+	Encounters     []NPCEncounter  `json:"encounters"`
+}
+
+// NPCEncounter records what happened the last time a worker met the clerk
+type NPCEncounter struct {
+	SessionID   string `json:"session_id"`
+	CaseID      string `json:"case_id"`
+	GameDate    string `json:"game_date"`
+	CampaignDay int    `json:"campaign_day,omitempty"`
+	Decision    string `json:"decision"` // "approve" or "deny"
+	Correct     bool   `json:"correct"`
+	Reason      string `json:"reason"` // Ground truth behind the correct decision
+}
+
+// Profile returns the NPC as a case profile
+func (n *RosterNPC) Profile() NPCProfile {
+	return NPCProfile{
+		NPCID:              n.NPCID,
+		Name:               n.Name,
+		Role:               n.Role,
+		Department:         n.Department,
+		Personality:        n.Personality,
+		VoiceID:            n.VoiceID,
+		Casting:            n.Casting,
+		Pronunciations:     n.Pronunciations,
+		Demeanor:           n.Demeanor,
+		PortraitSeed:       n.PortraitSeed,
+		PortraitURL:        PortraitURL(n.PortraitSeed),
+		Backstory:          n.Backstory,
+		History:            n.History(),
+		PreviousEncounters: len(n.Encounters),
+	}
+}
+
+// History summarizes past encounters from the worker's point of view, oldest first
+func (n *RosterNPC) History() []string {
+	history := make([]string, 0, len(n.Encounters))
+	for _, e := range n.Encounters {
+		when := e.GameDate
+		if e.CampaignDay > 0 {
+			when = fmt.Sprintf("Day %d (%s)", e.CampaignDay, e.GameDate)
+		}
+
+		var what string
+		switch {
+		case e.Decision == "approve" && e.Correct:
+			what = "the clerk approved you and you went home"
+		case e.Decision == "approve":
+			what = "the clerk let you through even though you had violations (" + e.Reason + ")"
+		case e.Correct:
+			what = "the clerk denied you (" + e.Reason + ")"
+		default:
+			what = "the clerk denied you UNFAIRLY - your papers were in order"
+		}
+		history = append(history, when+": "+what)
+	}
+	return history
+}
+
+// PortraitURL returns the generated avatar URL for a portrait seed
+func PortraitURL(seed string) string {
+	// Use notionists-neutral style for realistic human avatars
+	return fmt.Sprintf("https://api.dicebear.com/9.x/notionists-neutral/svg?seed=%s&backgroundColor=1a3a52", seed)
+}
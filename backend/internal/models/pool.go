@@ -0,0 +1,86 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PoolRuleSet is a set of daily rules with pre-generated cases in the case pool
+type PoolRuleSet struct {
+	Key        string         `json:"key"` // RuleSetKey(Rules)
+	Rules      []string       `json:"rules"`
+	GameDate   string         `json:"game_date"`   // Game date the cases were generated for
+	CaseCounts map[string]int `json:"case_counts"` // Pooled cases per difficulty
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// PooledCase is a validated case, with audio, that can be reused across sessions
+type PooledCase struct {
+	PoolID     string     `json:"pool_id"`
+	RuleSetKey string     `json:"rule_set_key"`
+	Difficulty Difficulty `json:"difficulty"`
+	GameDate   string     `json:"game_date"` // Game date the case was generated for
+	Case       Case       `json:"case"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// RuleSetKey identifies a set of rules regardless of order, case and spacing
+func RuleSetKey(rules []string) string {
+	normalized := make([]string, len(rules))
+	for i, rule := range rules {
+		normalized[i] = strings.Join(strings.Fields(strings.ToLower(rule)), " ")
+	}
+	slices.Sort(normalized)
+
+	sum := sha256.Sum256([]byte(strings.Join(normalized, "\n")))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// datePattern matches game dates embedded in document fields and rules
+var datePattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
+
+// DaysBetween returns the number of days from one game date to another,
+// or 0 if either date is invalid
+func DaysBetween(fromDate, toDate string) int {
+	from, err := time.Parse("2006-01-02", fromDate)
+	if err != nil {
+		return 0
+	}
+	to, err := time.Parse("2006-01-02", toDate)
+	if err != nil {
+		return 0
+	}
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// ShiftDatesIn moves every date in text by days
+func ShiftDatesIn(text string, days int) string {
+	if days == 0 {
+		return text
+	}
+	return datePattern.ReplaceAllStringFunc(text, func(value string) string {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return value
+		}
+		return date.AddDate(0, 0, days).Format("2006-01-02")
+	})
+}
+
+// ShiftDates moves every date in the case by days, so a case generated for
+// one game date stays consistent on another
+func (c *Case) ShiftDates(days int) {
+	if days == 0 {
+		return
+	}
+	for _, doc := range c.Documents {
+		for key, value := range doc.Fields {
+			doc.Fields[key] = ShiftDatesIn(value, days)
+		}
+	}
+	c.Truth.ActualTermEnd = ShiftDatesIn(c.Truth.ActualTermEnd, days)
+}
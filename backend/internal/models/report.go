@@ -0,0 +1,91 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ShiftReport is the end-of-shift debrief for a session
+type ShiftReport struct {
+	SessionID          string       `json:"session_id"`
+	GameDate           string       `json:"game_date"`
+	Score              int          `json:"score"`
+	CorrectDecisions   int          `json:"correct_decisions"`
+	IncorrectDecisions int          `json:"incorrect_decisions"`
+	Citations          int          `json:"citations"`
+	TotalCases         int          `json:"total_cases"`
+	Cases              []CaseReport `json:"cases"`
+}
+
+// CaseReport describes how the player handled one case
+type CaseReport struct {
+	CaseID              string        `json:"case_id"`
+	NPCName             string        `json:"npc_name"`
+	Decision            string        `json:"decision"` // Empty if the case was never decided
+	CorrectDecision     string        `json:"correct_decision"`
+	Correct             bool          `json:"correct"`
+	CaughtViolations    []Violation   `json:"caught_violations"`
+	MissedViolations    []Violation   `json:"missed_violations"`
+	QuestionsAsked      []string      `json:"questions_asked"`
+	SecondaryChecksUsed int           `json:"secondary_checks_used"`
+	DecisionTime        time.Duration `json:"decision_time"`
+}
+
+// Report builds the debrief for every case that was served
+func (s *Session) Report() ShiftReport {
+	report := ShiftReport{
+		SessionID:          s.SessionID,
+		GameDate:           s.GameDate,
+		Score:              s.Score,
+		CorrectDecisions:   s.CorrectDecisions,
+		IncorrectDecisions: s.IncorrectDecisions,
+		Citations:          s.Citations,
+		TotalCases:         s.CaseCount(),
+	}
+
+	for _, c := range s.Cases {
+		if c.ServedAt.IsZero() && c.Decision == "" {
+			continue
+		}
+		report.Cases = append(report.Cases, c.Report())
+	}
+
+	return report
+}
+
+// Report describes how the player handled the case
+func (c *Case) Report() CaseReport {
+	report := CaseReport{
+		CaseID:              c.CaseID,
+		NPCName:             c.NPC.Name,
+		Decision:            c.Decision,
+		CorrectDecision:     c.CorrectDecision,
+		Correct:             c.Decision != "" && c.Decision == c.CorrectDecision,
+		QuestionsAsked:      c.Questions(),
+		SecondaryChecksUsed: c.SecondaryChecksUsed,
+	}
+	if !c.ServedAt.IsZero() && !c.ResolvedAt.IsZero() {
+		report.DecisionTime = c.ResolvedAt.Sub(c.ServedAt)
+	}
+
+	// A correct denial without flags still counts as catching the violations
+	caughtAll := report.Correct && c.Decision == "deny" && len(c.FlaggedFields) == 0
+	for _, v := range c.Violations {
+		if caughtAll || v.flaggedIn(c.FlaggedFields) {
+			report.CaughtViolations = append(report.CaughtViolations, v)
+		} else {
+			report.MissedViolations = append(report.MissedViolations, v)
+		}
+	}
+
+	return report
+}
+
+func (v Violation) flaggedIn(flagged []Violation) bool {
+	for _, f := range flagged {
+		if strings.EqualFold(f.Document, v.Document) && strings.EqualFold(f.Field, v.Field) {
+			return true
+		}
+	}
+	return false
+}
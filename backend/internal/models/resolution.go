@@ -0,0 +1,117 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Outcome classifies a player's decision against the correct one
+type Outcome string
+
+const (
+	OutcomeCorrectApprove Outcome = "correct_approve"
+	OutcomeCorrectDeny    Outcome = "correct_deny"
+	OutcomeWrongApprove   Outcome = "wrong_approve" // Should have denied
+	OutcomeWrongDeny      Outcome = "wrong_deny"    // Should have approved
+)
+
+// OutcomeFor returns the outcome of an "approve" or "deny" decision
+func OutcomeFor(decision string, correct bool) Outcome {
+	switch {
+	case decision == "approve" && correct:
+		return OutcomeCorrectApprove
+	case decision == "deny" && correct:
+		return OutcomeCorrectDeny
+	case decision == "approve":
+		return OutcomeWrongApprove
+	default:
+		return OutcomeWrongDeny
+	}
+}
+
+// ScoreItem is one line of a stored score breakdown
+type ScoreItem struct {
+	Code   string `json:"code"`
+	Label  string `json:"label"`
+	Points int    `json:"points"`
+}
+
+// Resolution records how the player decided a case
+type Resolution struct {
+	CaseID         string        `json:"case_id"`
+	Decision       string        `json:"decision"` // "approve" or "deny"
+	FlaggedFields  []Violation   `json:"flagged_fields,omitempty"`
+	Outcome        Outcome       `json:"outcome"`
+	Correct        bool          `json:"correct"`
+	ScoreDelta     int           `json:"score_delta"`
+	ScoreBreakdown []ScoreItem   `json:"score_breakdown"`
+	DecisionTime   time.Duration `json:"decision_time"` // 0 when the shift clock is disabled
+	ResolvedAt     time.Time     `json:"resolved_at"`
+	Verdict        string        `json:"verdict"`
+	Reaction       string        `json:"reaction"`
+	Streak         int           `json:"streak"`    // Session streak after this decision
+	Citations      int           `json:"citations"` // Session citations after this decision
+	Degraded       bool          `json:"degraded"`  // Verdict or reaction is a fallback line, or the reaction audio failed
+
+	// Voiced reaction, kept so that replays don't synthesize it again. The
+	// audio is stored outside the session; see StoredAudio.
+	ReactionAudioRef    string         `json:"reaction_audio_ref,omitempty"`
+	ReactionAudioFormat string         `json:"reaction_audio_format,omitempty"`
+	ReactionSubtitles   []SubtitleWord `json:"reaction_subtitles,omitempty"`
+}
+
+// StoredAudio is voiced audio kept in its own document
+type StoredAudio struct {
+	Audio  []byte `json:"audio"`
+	Format string `json:"format"`
+}
+
+// Resolution returns the stored resolution of a case, or nil if it is undecided
+func (s *Session) Resolution(caseID string) *Resolution {
+	for i := range s.Resolutions {
+		if s.Resolutions[i].CaseID == caseID {
+			return &s.Resolutions[i]
+		}
+	}
+	return nil
+}
+
+// Resolve applies a decision to the session: score, counters, the case
+// itself and the list of completed cases. The resolution's Streak and
+// Citations are filled in from the updated session.
+func (s *Session) Resolve(r *Resolution) error {
+	var caseData *Case
+	for i := range s.Cases {
+		if s.Cases[i].CaseID == r.CaseID {
+			caseData = &s.Cases[i]
+			break
+		}
+	}
+	if caseData == nil {
+		return fmt.Errorf("case not found: %s", r.CaseID)
+	}
+
+	s.Score += r.ScoreDelta
+	if r.Correct {
+		s.CorrectDecisions++
+		s.Streak++
+	} else {
+		s.IncorrectDecisions++
+		s.Streak = 0
+		s.Citations++
+	}
+
+	// CurrentCaseIndex will equal the case count when all cases are done
+	s.CurrentCaseIndex++
+	s.CompletedCases = append(s.CompletedCases, r.CaseID)
+
+	caseData.Decision = r.Decision
+	caseData.FlaggedFields = r.FlaggedFields
+	caseData.ResolvedAt = r.ResolvedAt
+
+	r.Streak = s.Streak
+	r.Citations = s.Citations
+	s.Resolutions = append(s.Resolutions, *r)
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// SubtitleWord is when a word of a spoken line is heard
+type SubtitleWord struct {
+	Text   string        `json:"text"`
+	Offset int           `json:"offset"` // Character offset of the word in the line
+	Start  time.Duration `json:"start"`
+	End    time.Duration `json:"end"`
+}
@@ -0,0 +1,38 @@
+package models
+
+// Usage counts the paid AI calls made for a session, a player or the server
+type Usage struct {
+	LLMCalls      int64 `json:"llm_calls"`
+	PromptTokens  int64 `json:"prompt_tokens"`
+	OutputTokens  int64 `json:"output_tokens"` // Includes thinking tokens
+	TTSCalls      int64 `json:"tts_calls"`
+	TTSCharacters int64 `json:"tts_characters"`
+}
+
+// Add adds other's counts to u
+func (u *Usage) Add(other Usage) {
+	u.LLMCalls += other.LLMCalls
+	u.PromptTokens += other.PromptTokens
+	u.OutputTokens += other.OutputTokens
+	u.TTSCalls += other.TTSCalls
+	u.TTSCharacters += other.TTSCharacters
+}
+
+// IsZero reports whether nothing was used
+func (u Usage) IsZero() bool {
+	return u == Usage{}
+}
+
+// Spending is what counts toward a budget
+type Spending struct {
+	Tokens        int64 // LLM prompt and output tokens
+	TTSCharacters int64
+	Sessions      int64 // Sessions started
+}
+
+// Add adds other's counts to s
+func (s *Spending) Add(other Spending) {
+	s.Tokens += other.Tokens
+	s.TTSCharacters += other.TTSCharacters
+	s.Sessions += other.Sessions
+}
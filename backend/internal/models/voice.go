@@ -0,0 +1,21 @@
+package models
+
+// VoiceCasting describes how an NPC should sound. It is produced with the
+// case and matched against the voice registry.
+type VoiceCasting struct {
+	VocalAge string `json:"vocal_age"` // "young", "adult", "middle_aged" or "elderly"
+	Pitch    string `json:"pitch"`     // "low", "medium" or "high"
+	Timbre   string `json:"timbre"`    // e.g. "deep", "raspy", "smooth", "warm"
+	Accent   string `json:"accent"`    // Accent region, e.g. "american", "british", "south_asian"
+	Energy   string `json:"energy"`    // "low", "medium" or "high"
+}
+
+// Pronunciation tells the speech engine how to say a term. An alias is
+// spoken in place of the term; a phoneme spells it out exactly on engines
+// that support phonemes, and the alias is used elsewhere.
+type Pronunciation struct {
+	Term     string `json:"term"`
+	Alias    string `json:"alias,omitempty"`    // e.g. "Delta Seven" for "Delta-7"
+	Phoneme  string `json:"phoneme,omitempty"`  // e.g. "ŋwiən" for "Nguyen"
+	Alphabet string `json:"alphabet,omitempty"` // "ipa" (default) or "cmu-arpabet"
+}
@@ -0,0 +1,107 @@
+package resilience
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+)
+
+// ErrCircuitOpen is returned without calling a provider that keeps failing
+var ErrCircuitOpen = errors.New("circuit open")
+
+// Breaker stops calls to a provider after consecutive failures. Once the
+// cooldown has passed, a single trial call decides whether to close it again.
+type Breaker struct {
+	provider  string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time // Zero while closed
+	probing  bool      // A trial call is in flight
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = map[string]*Breaker{}
+)
+
+// BreakerFor returns the breaker shared by all calls to a provider. The
+// threshold and cooldown of the first call win; a threshold of 0 never opens.
+func BreakerFor(provider string, threshold int, cooldown time.Duration) *Breaker {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+
+	b, ok := breakers[provider]
+	if !ok {
+		b = &Breaker{provider: provider, threshold: threshold, cooldown: cooldown}
+		breakers[provider] = b
+	}
+	return b
+}
+
+// ConfiguredBreaker returns a provider's breaker with the configured settings
+func ConfiguredBreaker(cfg *config.Config, provider string) *Breaker {
+	return BreakerFor(provider, cfg.CircuitBreakerThreshold, cfg.CircuitBreakerCooldown)
+}
+
+// Open reports whether calls are being refused
+func (b *Breaker) Open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.openedAt.IsZero() && (time.Since(b.openedAt) < b.cooldown || b.probing)
+}
+
+// allow returns ErrCircuitOpen if a call must not be made
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return nil
+	}
+	if time.Since(b.openedAt) < b.cooldown || b.probing {
+		return fmt.Errorf("%w: %s is failing, retrying after %s", ErrCircuitOpen, b.provider, b.openedAt.Add(b.cooldown).Format(time.TimeOnly))
+	}
+	b.probing = true
+	return nil
+}
+
+// record updates the breaker with the result of a call. Errors that aren't
+// retryable, such as bad requests, show the provider is up.
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probing := b.probing
+	b.probing = false
+
+	if err == nil || !Retryable(err) {
+		if !b.openedAt.IsZero() {
+			log.Printf("Circuit for %s closed", b.provider)
+		}
+		b.failures = 0
+		b.openedAt = time.Time{}
+		return
+	}
+
+	b.failures++
+	if b.threshold > 0 && (probing || b.failures >= b.threshold) {
+		if !probing {
+			log.Printf("Warning: Circuit for %s opened after %d failures: %v", b.provider, b.failures, err)
+		}
+		b.openedAt = time.Now()
+	}
+}
+
+// abandon ends a call whose result says nothing about the provider
+func (b *Breaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
@@ -0,0 +1,128 @@
+package resilience
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var (
+	errUnavailable = &StatusError{Provider: "test", StatusCode: http.StatusServiceUnavailable}
+	errBadRequest  = &StatusError{Provider: "test", StatusCode: http.StatusBadRequest}
+)
+
+// cooledDown moves an open breaker's opening back past its cooldown
+func cooledDown(b *Breaker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.openedAt = time.Now().Add(-2 * b.cooldown)
+}
+
+func TestBreaker(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures []error // Recorded in order before the check
+		cooled   bool    // Whether the cooldown has passed since
+		wantOpen bool
+	}{
+		{"closed", nil, false, false},
+		{"below threshold", []error{errUnavailable, errUnavailable}, false, false},
+		{"at threshold", []error{errUnavailable, errUnavailable, errUnavailable}, false, true},
+		{"success resets the count", []error{errUnavailable, errUnavailable, nil, errUnavailable}, false, false},
+		{"bad requests show the provider is up", []error{errUnavailable, errUnavailable, errBadRequest, errUnavailable}, false, false},
+		{"cooldown passed", []error{errUnavailable, errUnavailable, errUnavailable}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Breaker{provider: "test", threshold: 3, cooldown: time.Minute}
+			for _, err := range tt.failures {
+				b.record(err)
+			}
+			if tt.cooled {
+				cooledDown(b)
+			}
+
+			if got := b.Open(); got != tt.wantOpen {
+				t.Errorf("Open() = %v, want %v", got, tt.wantOpen)
+			}
+			if err := b.allow(); (err != nil) != tt.wantOpen {
+				t.Errorf("allow() = %v, want open %v", err, tt.wantOpen)
+			}
+		})
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		trial    error // Result of the trial call
+		wantOpen bool
+	}{
+		{"trial succeeds", nil, false},
+		{"trial fails", errUnavailable, true},
+		{"trial rejected as a bad request", errBadRequest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Breaker{provider: "test", threshold: 2, cooldown: time.Minute}
+			b.record(errUnavailable)
+			b.record(errUnavailable)
+			cooledDown(b)
+
+			if err := b.allow(); err != nil {
+				t.Fatalf("trial call refused: %v", err)
+			}
+			// Only one trial call at a time
+			if err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("second call during the trial = %v, want ErrCircuitOpen", err)
+			}
+			if !b.Open() {
+				t.Fatal("breaker reported closed during the trial")
+			}
+
+			// A failed trial reopens the circuit at once, below the threshold
+			b.record(tt.trial)
+			if got := b.Open(); got != tt.wantOpen {
+				t.Errorf("Open() after trial = %v, want %v", got, tt.wantOpen)
+			}
+		})
+	}
+}
+
+func TestBreakerAbandonedTrial(t *testing.T) {
+	b := &Breaker{provider: "test", threshold: 1, cooldown: time.Minute}
+	b.record(errUnavailable)
+	cooledDown(b)
+
+	if err := b.allow(); err != nil {
+		t.Fatalf("trial call refused: %v", err)
+	}
+	b.abandon()
+
+	// The trial said nothing, so the next call is another trial
+	if err := b.allow(); err != nil {
+		t.Errorf("call after an abandoned trial = %v, want a new trial", err)
+	}
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	b := &Breaker{provider: "test", threshold: 0, cooldown: time.Minute}
+	for range 100 {
+		b.record(errUnavailable)
+	}
+	if b.Open() || b.allow() != nil {
+		t.Error("breaker with threshold 0 opened")
+	}
+}
+
+func TestBreakerFor(t *testing.T) {
+	first := BreakerFor("test-shared", 3, time.Minute)
+	second := BreakerFor("test-shared", 10, time.Hour)
+	if first != second {
+		t.Fatal("calls to a provider got different breakers")
+	}
+	if second.threshold != 3 || second.cooldown != time.Minute {
+		t.Errorf("settings = %d, %s; want the first call's", second.threshold, second.cooldown)
+	}
+}
@@ -0,0 +1,23 @@
+package resilience
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type fallbackKey struct{}
+
+// TrackFallbacks returns a context in which fallbacks are noted, and a func
+// that reports whether any happened so far
+func TrackFallbacks(ctx context.Context) (context.Context, func() bool) {
+	fell := new(atomic.Bool)
+	return context.WithValue(ctx, fallbackKey{}, fell), fell.Load
+}
+
+// Fallback notes that content made for ctx is a placeholder rather than the
+// provider's
+func Fallback(ctx context.Context) {
+	if fell, ok := ctx.Value(fallbackKey{}).(*atomic.Bool); ok {
+		fell.Store(true)
+	}
+}
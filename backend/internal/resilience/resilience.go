@@ -0,0 +1,166 @@
+// Package resilience guards calls to external AI providers with per-call
+// timeouts, retries with jittered backoff and a circuit breaker per
+// provider, and lets handlers tell when a response fell back to placeholder
+// content.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+)
+
+// StatusError is an error response from a provider's HTTP API
+type StatusError struct {
+	Provider   string
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s API error (status %d): %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// Retryable reports whether a failed call may succeed if it is tried again:
+// rate limits, server errors, timeouts and dropped connections
+func Retryable(err error) bool {
+	var status *StatusError
+	if errors.As(err, &status) {
+		switch status.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+			http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &netErr)
+}
+
+// Policy says how hard to try a call
+type Policy struct {
+	Attempts  int           // Tries per call, including the first
+	BaseDelay time.Duration // Backoff before the first retry; doubles on each retry
+	MaxDelay  time.Duration // Cap on the backoff
+	Timeout   time.Duration // Per attempt; 0 means none
+}
+
+// ConfiguredPolicy returns the configured retry policy with a per-attempt timeout
+func ConfiguredPolicy(cfg *config.Config, timeout time.Duration) Policy {
+	return Policy{
+		Attempts:  cfg.RetryAttempts,
+		BaseDelay: cfg.RetryBaseDelay,
+		MaxDelay:  cfg.RetryMaxDelay,
+		Timeout:   timeout,
+	}
+}
+
+// backoff returns a jittered delay before retry number n, counting from 1
+func (p Policy) backoff(n int) time.Duration {
+	delay := p.BaseDelay << (n - 1)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Half fixed, half random, so that retries from many calls spread out
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// Guard applies a retry policy and a provider's circuit breaker to calls
+type Guard struct {
+	provider string
+	policy   Policy
+	breaker  *Breaker
+}
+
+// NewGuard creates a guard for calls to a provider
+func NewGuard(provider string, policy Policy, breaker *Breaker) *Guard {
+	return &Guard{provider: provider, policy: policy, breaker: breaker}
+}
+
+// Open reports whether the provider's circuit is open, so that callers can
+// fall back without trying
+func (g *Guard) Open() bool {
+	return g.breaker.Open()
+}
+
+// Call runs call under the guard, retrying retryable failures
+func Call[T any](ctx context.Context, g *Guard, call func(ctx context.Context) (T, error)) (T, error) {
+	result, cancel, err := do(ctx, g, call)
+	cancel()
+	return result, err
+}
+
+// CallStream is Call for calls that return a stream. The timeout only
+// covers opening the stream; the returned cancel func ends the stream's
+// context and must be called once the stream is done with.
+func CallStream[T any](ctx context.Context, g *Guard, call func(ctx context.Context) (T, error)) (T, context.CancelFunc, error) {
+	return do(ctx, g, call)
+}
+
+// do runs the attempts. On success the last attempt's context is left
+// running for the caller to cancel.
+func do[T any](ctx context.Context, g *Guard, call func(ctx context.Context) (T, error)) (T, context.CancelFunc, error) {
+	var zero T
+	attempts := max(g.policy.Attempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		if err := g.breaker.allow(); err != nil {
+			return zero, func() {}, err
+		}
+
+		attemptCtx, cancel := context.WithCancel(ctx)
+		var timer *time.Timer
+		if g.policy.Timeout > 0 {
+			timer = time.AfterFunc(g.policy.Timeout, cancel)
+		}
+		result, err := call(attemptCtx)
+		timedOut := timer != nil && !timer.Stop()
+
+		if err == nil && !timedOut {
+			g.breaker.record(nil)
+			return result, cancel, nil
+		}
+		cancel()
+		if err == nil {
+			// Finished just as the timeout fired; the result may be cut off
+			if c, ok := any(result).(io.Closer); ok {
+				c.Close()
+			}
+			err = context.DeadlineExceeded
+		}
+		if timedOut && errors.Is(err, context.Canceled) {
+			err = fmt.Errorf("%s call timed out after %s: %w", g.provider, g.policy.Timeout, context.DeadlineExceeded)
+		}
+
+		// The caller gave up; that says nothing about the provider
+		if ctx.Err() != nil {
+			g.breaker.abandon()
+			return zero, func() {}, err
+		}
+
+		g.breaker.record(err)
+		if !Retryable(err) || attempt >= attempts {
+			return zero, func() {}, err
+		}
+
+		delay := g.policy.backoff(attempt)
+		log.Printf("Warning: %s call failed (attempt %d/%d), retrying in %s: %v", g.provider, attempt, attempts, delay.Round(time.Millisecond), err)
+		select {
+		case <-ctx.Done():
+			return zero, func() {}, err
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rate limited", &StatusError{StatusCode: http.StatusTooManyRequests}, true},
+		{"server error", &StatusError{StatusCode: http.StatusInternalServerError}, true},
+		{"unavailable", &StatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"gateway timeout", &StatusError{StatusCode: http.StatusGatewayTimeout}, true},
+		{"bad request", &StatusError{StatusCode: http.StatusBadRequest}, false},
+		{"unauthorized", &StatusError{StatusCode: http.StatusUnauthorized}, false},
+		{"wrapped status", fmt.Errorf("failed: %w", &StatusError{StatusCode: http.StatusBadGateway}), true},
+		{"deadline", context.DeadlineExceeded, true},
+		{"dropped connection", io.ErrUnexpectedEOF, true},
+		{"cancelled", context.Canceled, false},
+		{"other", errors.New("parse error"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retryable(tt.err); got != tt.want {
+				t.Errorf("Retryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := Policy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		retry int
+		delay time.Duration // Before jitter
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{70, time.Second}, // Shifted past the width of a Duration
+	}
+	for _, tt := range tests {
+		for range 50 {
+			if got := p.backoff(tt.retry); got < tt.delay/2 || got > tt.delay {
+				t.Fatalf("backoff(%d) = %s, want between %s and %s", tt.retry, got, tt.delay/2, tt.delay)
+			}
+		}
+	}
+
+	if got := (Policy{}).backoff(1); got != 0 {
+		t.Errorf("backoff without delays = %s, want 0", got)
+	}
+}
+
+// testGuard returns a guard with its own breaker and no backoff
+func testGuard(attempts, threshold int, timeout time.Duration) *Guard {
+	breaker := &Breaker{provider: "test", threshold: threshold, cooldown: time.Minute}
+	return NewGuard("test", Policy{Attempts: attempts, Timeout: timeout}, breaker)
+}
+
+func TestCall(t *testing.T) {
+	tests := []struct {
+		name      string
+		attempts  int
+		results   []error // Per attempt; attempts past the end succeed
+		wantCalls int
+		wantErr   bool
+	}{
+		{"first try", 3, nil, 1, false},
+		{"retried until it succeeds", 3, []error{errUnavailable, errUnavailable}, 3, false},
+		{"gives up after the attempts", 3, []error{errUnavailable, errUnavailable, errUnavailable, errUnavailable}, 3, true},
+		{"no retry for bad requests", 3, []error{errBadRequest}, 1, true},
+		{"zero attempts still tries once", 0, []error{errUnavailable}, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := Call(context.Background(), testGuard(tt.attempts, 0, 0), func(ctx context.Context) (string, error) {
+				calls++
+				if calls <= len(tt.results) && tt.results[calls-1] != nil {
+					return "", tt.results[calls-1]
+				}
+				return "ok", nil
+			})
+
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && got != "ok" {
+				t.Errorf("result = %q, want ok", got)
+			}
+		})
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	calls := 0
+	_, err := Call(context.Background(), testGuard(2, 0, 10*time.Millisecond), func(ctx context.Context) (string, error) {
+		calls++
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want a deadline", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want a retry after the timeout", calls)
+	}
+}
+
+func TestCallTimeoutAtCompletion(t *testing.T) {
+	// A result returned after the timeout fired may be cut off
+	_, err := Call(context.Background(), testGuard(1, 0, 10*time.Millisecond), func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "partial", nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want a deadline", err)
+	}
+}
+
+func TestCallCallerCancelled(t *testing.T) {
+	g := testGuard(3, 1, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	_, err := Call(ctx, g, func(ctx context.Context) (string, error) {
+		calls++
+		cancel()
+		return "", context.Canceled
+	})
+
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Errorf("err = %v after %d calls, want cancelled after 1", err, calls)
+	}
+	if g.Open() {
+		t.Error("the caller giving up opened the circuit")
+	}
+}
+
+func TestCallOpenCircuit(t *testing.T) {
+	g := testGuard(5, 2, 0)
+
+	calls := 0
+	_, err := Call(context.Background(), g, func(ctx context.Context) (string, error) {
+		calls++
+		return "", errUnavailable
+	})
+
+	// The breaker opens mid-retry and stops the remaining attempts
+	if !errors.Is(err, ErrCircuitOpen) || calls != 2 {
+		t.Errorf("err = %v after %d calls, want ErrCircuitOpen after 2", err, calls)
+	}
+	if !g.Open() {
+		t.Error("circuit not open")
+	}
+
+	_, err = Call(context.Background(), g, func(ctx context.Context) (string, error) {
+		t.Error("called with the circuit open")
+		return "", nil
+	})
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("err = %v, want ErrCircuitOpen", err)
+	}
+}
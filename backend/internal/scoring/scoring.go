@@ -0,0 +1,107 @@
+package scoring
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Input describes a resolved case and the shift so far
+type Input struct {
+	Correct             bool
+	Decision            string        // "approve" or "deny"
+	DecisionTime        time.Duration // 0 when the shift clock is disabled
+	Streak              int           // Consecutive correct decisions before this one
+	Citations           int           // Citations issued earlier in the shift
+	SecondaryChecksUsed int           // Secondary checks used on this case
+	FlaggedFields       []Field       // Fields the player flagged as violations
+	ViolationFields     []Field       // Fields that actually violate the rules
+}
+
+// Field identifies a field on a document, e.g. clearance_form/cargo1
+type Field struct {
+	Document string
+	Name     string
+}
+
+func (f Field) matches(other Field) bool {
+	return strings.EqualFold(f.Document, other.Document) && strings.EqualFold(f.Name, other.Name)
+}
+
+// Item is one line of a score breakdown
+type Item struct {
+	Code   string // Stable identifier, e.g. "streak"
+	Label  string // Player-facing explanation
+	Points int
+}
+
+// Breakdown is an itemized score for one case
+type Breakdown struct {
+	Items []Item
+}
+
+// Add appends a line item
+func (b *Breakdown) Add(code string, points int, label string) {
+	b.Items = append(b.Items, Item{Code: code, Label: label, Points: points})
+}
+
+// Total returns the sum of all line items
+func (b *Breakdown) Total() int {
+	total := 0
+	for _, item := range b.Items {
+		total += item.Points
+	}
+	return total
+}
+
+// Strategy adds line items for one scoring concern
+type Strategy interface {
+	Apply(in Input, b *Breakdown)
+}
+
+// Engine scores cases by running its strategies in order
+type Engine struct {
+	strategies []Strategy
+}
+
+// NewEngine creates an engine from explicit strategies
+func NewEngine(strategies ...Strategy) *Engine {
+	return &Engine{strategies: strategies}
+}
+
+// Score returns the itemized score for a resolved case
+func (e *Engine) Score(in Input) Breakdown {
+	var b Breakdown
+	for _, strategy := range e.strategies {
+		strategy.Apply(in, &b)
+	}
+	return b
+}
+
+// DefaultStrategies lists every built-in strategy in evaluation order
+var DefaultStrategies = []string{"outcome", "streak", "speed", "citations", "checks", "flags"}
+
+// NewEngineFromNames builds an engine from built-in strategy names with default tuning
+func NewEngineFromNames(names []string) (*Engine, error) {
+	strategies := make([]Strategy, 0, len(names))
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "outcome":
+			strategies = append(strategies, Outcome{Correct: 10, Wrong: -15})
+		case "streak":
+			strategies = append(strategies, StreakMultiplier{Every: 3, Step: 0.5, Max: 2.0})
+		case "speed":
+			strategies = append(strategies, DecisionSpeed{Fast: 20 * time.Second, Slow: 90 * time.Second, Bonus: 3, Penalty: -5})
+		case "citations":
+			strategies = append(strategies, Citations{Warnings: 2, Docking: -5})
+		case "checks":
+			strategies = append(strategies, SecondaryCheckCost{PerCheck: -2})
+		case "flags":
+			strategies = append(strategies, FlaggedViolations{PerField: 3})
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown scoring strategy: %s", name)
+		}
+	}
+	return NewEngine(strategies...), nil
+}
@@ -0,0 +1,233 @@
+package scoring
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		correct bool
+		want    int
+	}{
+		{"correct", true, 10},
+		{"wrong", false, -15},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Breakdown
+			Outcome{Correct: 10, Wrong: -15}.Apply(Input{Correct: tt.correct}, &b)
+			if got := b.Total(); got != tt.want {
+				t.Errorf("total = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreakMultiplier(t *testing.T) {
+	streak := StreakMultiplier{Every: 3, Step: 0.5, Max: 2.0}
+	tests := []struct {
+		name    string
+		correct bool
+		streak  int // Before this decision
+		base    int // Points earned before the strategy runs
+		want    int // Bonus added, 0 for no item
+	}{
+		{"short streak", true, 1, 10, 0},
+		{"third in a row", true, 2, 10, 5},
+		{"sixth in a row", true, 5, 10, 10},
+		{"capped", true, 20, 10, 10},
+		{"wrong decision", false, 8, 10, 0},
+		{"nothing to multiply", true, 5, 0, 0},
+		{"negative base", true, 5, -4, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Breakdown
+			b.Add("base", tt.base, "base")
+			streak.Apply(Input{Correct: tt.correct, Streak: tt.streak}, &b)
+			if got := b.Total() - tt.base; got != tt.want {
+				t.Errorf("bonus = %d, want %d", got, tt.want)
+			}
+			if tt.want == 0 && len(b.Items) != 1 {
+				t.Errorf("unexpected item %+v", b.Items[1:])
+			}
+		})
+	}
+}
+
+func TestDecisionSpeed(t *testing.T) {
+	speed := DecisionSpeed{Fast: 20 * time.Second, Slow: 90 * time.Second, Bonus: 3, Penalty: -5}
+	tests := []struct {
+		name  string
+		time  time.Duration
+		want  int
+		items int
+	}{
+		{"clock disabled", 0, 0, 0},
+		{"quick", 5 * time.Second, 3, 1},
+		{"at the fast limit", 20 * time.Second, 3, 1},
+		{"ordinary", time.Minute, 0, 0},
+		{"at the slow limit", 90 * time.Second, 0, 0},
+		{"slow", 2 * time.Minute, -5, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Breakdown
+			speed.Apply(Input{Correct: true, DecisionTime: tt.time}, &b)
+			if b.Total() != tt.want || len(b.Items) != tt.items {
+				t.Errorf("got %+v, want total %d in %d items", b.Items, tt.want, tt.items)
+			}
+		})
+	}
+}
+
+func TestCitations(t *testing.T) {
+	citations := Citations{Warnings: 2, Docking: -5}
+	tests := []struct {
+		name      string
+		correct   bool
+		citations int // Issued earlier in the shift
+		want      int
+		items     int
+	}{
+		{"correct decision", true, 4, 0, 0},
+		{"first warning", false, 0, 0, 1},
+		{"second warning", false, 1, 0, 1},
+		{"first docking", false, 2, -5, 1},
+		{"docking grows", false, 3, -10, 1},
+		{"and keeps growing", false, 5, -20, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Breakdown
+			citations.Apply(Input{Correct: tt.correct, Citations: tt.citations}, &b)
+			if b.Total() != tt.want || len(b.Items) != tt.items {
+				t.Errorf("got %+v, want total %d in %d items", b.Items, tt.want, tt.items)
+			}
+		})
+	}
+}
+
+func TestSecondaryCheckCost(t *testing.T) {
+	tests := []struct {
+		checks int
+		want   int
+		items  int
+	}{
+		{0, 0, 0},
+		{1, -2, 1},
+		{3, -6, 1},
+	}
+	for _, tt := range tests {
+		var b Breakdown
+		SecondaryCheckCost{PerCheck: -2}.Apply(Input{SecondaryChecksUsed: tt.checks}, &b)
+		if b.Total() != tt.want || len(b.Items) != tt.items {
+			t.Errorf("%d checks: got %+v, want total %d in %d items", tt.checks, b.Items, tt.want, tt.items)
+		}
+	}
+}
+
+func TestFlaggedViolations(t *testing.T) {
+	violations := []Field{{"clearance_form", "cargo1"}, {"employee_badge", "expire_date"}}
+	tests := []struct {
+		name     string
+		correct  bool
+		decision string
+		flagged  []Field
+		want     int
+	}{
+		{"both caught", true, "deny", []Field{{"clearance_form", "cargo1"}, {"employee_badge", "expire_date"}}, 6},
+		{"case-insensitive", true, "deny", []Field{{"Clearance_Form", "CARGO1"}}, 3},
+		{"flagged twice counts once", true, "deny", []Field{{"clearance_form", "cargo1"}, {"clearance_form", "cargo1"}}, 3},
+		{"wrong field", true, "deny", []Field{{"clearance_form", "cargo2"}}, 0},
+		{"wrong decision", false, "deny", violations, 0},
+		{"approvals earn nothing", true, "approve", violations, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Breakdown
+			FlaggedViolations{PerField: 3}.Apply(Input{Correct: tt.correct, Decision: tt.decision, FlaggedFields: tt.flagged, ViolationFields: violations}, &b)
+			if got := b.Total(); got != tt.want {
+				t.Errorf("total = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEngineFromNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		in      Input
+		want    []string // Item codes
+		wantErr bool
+	}{
+		{
+			name:  "defaults in order",
+			names: DefaultStrategies,
+			in:    Input{Correct: false, Decision: "approve", DecisionTime: 5 * time.Second, Citations: 2, SecondaryChecksUsed: 1},
+			want:  []string{"outcome", "speed", "citation", "secondary_checks"},
+		},
+		{
+			name:  "spaces and blanks",
+			names: []string{" outcome", "", "streak "},
+			in:    Input{Correct: true, Streak: 2},
+			want:  []string{"outcome", "streak"},
+		},
+		{
+			name:  "none",
+			names: nil,
+			in:    Input{Correct: true},
+			want:  nil,
+		},
+		{
+			name:    "unknown",
+			names:   []string{"outcome", "bribes"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, err := NewEngineFromNames(tt.names)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var codes []string
+			for _, item := range engine.Score(tt.in).Items {
+				codes = append(codes, item.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.want) {
+				t.Errorf("codes = %v, want %v", codes, tt.want)
+			}
+		})
+	}
+}
+
+func TestEngineStreakMultipliesEarlierItems(t *testing.T) {
+	engine, err := NewEngineFromNames([]string{"outcome", "streak", "flags"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The streak multiplies the outcome but not the flags scored after it
+	b := engine.Score(Input{
+		Correct:         true,
+		Decision:        "deny",
+		Streak:          2,
+		FlaggedFields:   []Field{{"clearance_form", "cargo1"}},
+		ViolationFields: []Field{{"clearance_form", "cargo1"}},
+	})
+	if got, want := b.Total(), 10+5+3; got != want {
+		t.Errorf("total = %d, want %d (%+v)", got, want, b.Items)
+	}
+}
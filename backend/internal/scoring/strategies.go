@@ -0,0 +1,130 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Outcome awards or deducts points for the decision itself
+type Outcome struct {
+	Correct int
+	Wrong   int
+}
+
+func (s Outcome) Apply(in Input, b *Breakdown) {
+	if in.Correct {
+		b.Add("outcome", s.Correct, "Correct decision")
+	} else {
+		b.Add("outcome", s.Wrong, "Wrong decision")
+	}
+}
+
+// StreakMultiplier scales the points earned so far for long correct streaks.
+// Every consecutive Every correct decisions raise the multiplier by Step, up to Max.
+type StreakMultiplier struct {
+	Every int
+	Step  float64
+	Max   float64
+}
+
+func (s StreakMultiplier) Apply(in Input, b *Breakdown) {
+	if !in.Correct || s.Every <= 0 {
+		return
+	}
+
+	streak := in.Streak + 1
+	multiplier := math.Min(1+float64(streak/s.Every)*s.Step, s.Max)
+	if multiplier <= 1 {
+		return
+	}
+
+	base := b.Total()
+	if base <= 0 {
+		return
+	}
+
+	bonus := int(math.Round(float64(base) * (multiplier - 1)))
+	b.Add("streak", bonus, fmt.Sprintf("Streak of %d: x%.1f", streak, multiplier))
+}
+
+// DecisionSpeed rewards quick decisions and penalizes slow ones
+type DecisionSpeed struct {
+	Fast    time.Duration // Decisions at or under this earn Bonus
+	Slow    time.Duration // Decisions over this cost Penalty
+	Bonus   int
+	Penalty int
+}
+
+func (s DecisionSpeed) Apply(in Input, b *Breakdown) {
+	seconds := int(in.DecisionTime.Seconds())
+	switch {
+	case in.DecisionTime <= 0:
+		return
+	case in.DecisionTime <= s.Fast:
+		b.Add("speed", s.Bonus, fmt.Sprintf("Quick decision (%ds)", seconds))
+	case in.DecisionTime > s.Slow:
+		b.Add("speed", s.Penalty, fmt.Sprintf("Slow decision (%ds)", seconds))
+	}
+}
+
+// Citations issues a citation for every wrong decision. The first Warnings
+// citations of a shift are warnings; every citation after that docks pay,
+// and the docking grows with each citation.
+type Citations struct {
+	Warnings int
+	Docking  int
+}
+
+func (s Citations) Apply(in Input, b *Breakdown) {
+	if in.Correct {
+		return
+	}
+
+	citation := in.Citations + 1
+	if citation <= s.Warnings {
+		b.Add("citation", 0, fmt.Sprintf("Citation %d: warning", citation))
+		return
+	}
+
+	docked := s.Docking * (citation - s.Warnings)
+	b.Add("citation", docked, fmt.Sprintf("Citation %d: pay docked", citation))
+}
+
+// SecondaryCheckCost charges for each secondary check used on the case
+type SecondaryCheckCost struct {
+	PerCheck int
+}
+
+func (s SecondaryCheckCost) Apply(in Input, b *Breakdown) {
+	if in.SecondaryChecksUsed == 0 {
+		return
+	}
+	b.Add("secondary_checks", s.PerCheck*in.SecondaryChecksUsed, fmt.Sprintf("Secondary checks used: %d", in.SecondaryChecksUsed))
+}
+
+// FlaggedViolations rewards correctly flagged violating fields on correct denials
+type FlaggedViolations struct {
+	PerField int
+}
+
+func (s FlaggedViolations) Apply(in Input, b *Breakdown) {
+	if !in.Correct || in.Decision != "deny" {
+		return
+	}
+
+	caught := 0
+	for _, violation := range in.ViolationFields {
+		for _, flagged := range in.FlaggedFields {
+			if flagged.matches(violation) {
+				caught++
+				break
+			}
+		}
+	}
+	if caught == 0 {
+		return
+	}
+
+	b.Add("flags", s.PerField*caught, fmt.Sprintf("Flagged %d violation(s)", caught))
+}
@@ -7,26 +7,73 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/usage"
 )
 
 const (
 	apiBaseURL = "https://api.elevenlabs.io/v1"
+
+	// DefaultModel is the text-to-speech model used when none is configured
+	DefaultModel = "eleven_turbo_v2_5"
+
+	// DefaultOutputFormat is the audio format ElevenLabs returns when none is requested
+	DefaultOutputFormat = "mp3_44100_128"
 )
 
 // Client handles ElevenLabs text-to-speech API
 type Client struct {
 	apiKey     string
+	model      string
+	lexicon    *lexicon.Lexicon
 	httpClient *http.Client
 }
 
-// NewClient creates a new ElevenLabs client
-func NewClient(apiKey string) *Client {
+// NewClient creates a new ElevenLabs client for a text-to-speech model
+func NewClient(apiKey, model string) *Client {
+	if model == "" {
+		model = DefaultModel
+	}
+
 	return &Client{
 		apiKey:     apiKey,
+		model:      model,
 		httpClient: &http.Client{},
 	}
 }
 
+// Model returns the text-to-speech model the client uses
+func (c *Client) Model() string {
+	return c.model
+}
+
+// WithLexicon makes the client apply a pronunciation lexicon, plus the
+// entries carried by each call's context, to text before synthesis
+func (c *Client) WithLexicon(lex *lexicon.Lexicon) *Client {
+	c.lexicon = lex
+	return c
+}
+
+// SupportsPhonemes reports whether a model honours SSML phoneme tags
+func SupportsPhonemes(model string) bool {
+	switch model {
+	case "eleven_flash_v2", "eleven_turbo_v2", "eleven_monolingual_v1":
+		return true
+	default:
+		return false
+	}
+}
+
+// speakableText applies the lexicon to text
+func (c *Client) speakableText(ctx context.Context, text string) string {
+	return c.lexicon.Apply(text, lexicon.Pronunciations(ctx), SupportsPhonemes(c.model))
+}
+
 // TextToSpeechRequest represents the API request payload
 type TextToSpeechRequest struct {
 	Text          string                 `json:"text"`
@@ -38,12 +85,16 @@ type TextToSpeechRequest struct {
 type EmotionType string
 
 const (
-	EmotionNeutral  EmotionType = "neutral"
-	EmotionHappy    EmotionType = "happy"
-	EmotionAngry    EmotionType = "angry"
-	EmotionFurious  EmotionType = "furious"
-	EmotionSad      EmotionType = "sad"
-	EmotionNervous  EmotionType = "nervous"
+	EmotionNeutral    EmotionType = "neutral"
+	EmotionHappy      EmotionType = "happy"
+	EmotionAngry      EmotionType = "angry"
+	EmotionFurious    EmotionType = "furious"
+	EmotionSad        EmotionType = "sad"
+	EmotionNervous    EmotionType = "nervous"
+	EmotionSarcastic  EmotionType = "sarcastic"
+	EmotionPleading   EmotionType = "pleading"
+	EmotionSuspicious EmotionType = "suspicious"
+	EmotionRelieved   EmotionType = "relieved"
 )
 
 // getVoiceSettings returns optimized voice settings based on emotion
@@ -60,59 +111,105 @@ const (
 // - Furious: Lowest stability for maximum rage, highest style for extreme emotion
 // - Sad: Higher stability for somber tone, moderate style
 // - Nervous: Low stability for jittery delivery
+// - Sarcastic: Low stability and high style for a dry, mocking edge
+// - Pleading: Low stability for a wavering, desperate delivery
+// - Suspicious: Moderate stability for a guarded, measured tone
+// - Relieved: Moderate stability and style for an exhale of relief
 // - Neutral: Balanced settings
+//
+// These are the defaults; the voice catalog can override them per voice and per model.
 func (c *Client) getVoiceSettings(emotion EmotionType) map[string]interface{} {
 	switch emotion {
 	case EmotionHappy:
 		// Higher stability for clear, upbeat delivery
 		return map[string]interface{}{
-			"stability":        0.35,
-			"similarity_boost": 0.75,
-			"style":            0.5,  // Exaggerate emotion
+			"stability":         0.35,
+			"similarity_boost":  0.75,
+			"style":             0.5, // Exaggerate emotion
 			"use_speaker_boost": true,
 		}
 	case EmotionAngry:
 		// Lower stability for more aggressive, varied delivery
 		return map[string]interface{}{
-			"stability":        0.25,
-			"similarity_boost": 0.65,
-			"style":            0.75, // High style for emotion
+			"stability":         0.25,
+			"similarity_boost":  0.65,
+			"style":             0.75, // High style for emotion
 			"use_speaker_boost": true,
 		}
 	case EmotionFurious:
 		// Maximum emotion, lowest stability for intense rage
 		return map[string]interface{}{
-			"stability":        0.15,
-			"similarity_boost": 0.60,
-			"style":            0.9,  // Maximum style/emotion
+			"stability":         0.15,
+			"similarity_boost":  0.60,
+			"style":             0.9, // Maximum style/emotion
 			"use_speaker_boost": true,
 		}
 	case EmotionSad:
 		// Moderate stability, lower boost for somber tone
 		return map[string]interface{}{
-			"stability":        0.50,
-			"similarity_boost": 0.70,
-			"style":            0.4,
+			"stability":         0.50,
+			"similarity_boost":  0.70,
+			"style":             0.4,
 			"use_speaker_boost": true,
 		}
 	case EmotionNervous:
 		// Lower stability for anxious, jittery delivery
 		return map[string]interface{}{
-			"stability":        0.30,
-			"similarity_boost": 0.75,
-			"style":            0.5,
+			"stability":         0.30,
+			"similarity_boost":  0.75,
+			"style":             0.5,
+			"use_speaker_boost": true,
+		}
+	case EmotionSarcastic:
+		// Dry, mocking delivery
+		return map[string]interface{}{
+			"stability":         0.35,
+			"similarity_boost":  0.75,
+			"style":             0.6,
+			"use_speaker_boost": true,
+		}
+	case EmotionPleading:
+		// Wavering, desperate delivery
+		return map[string]interface{}{
+			"stability":         0.25,
+			"similarity_boost":  0.70,
+			"style":             0.65,
+			"use_speaker_boost": true,
+		}
+	case EmotionSuspicious:
+		// Guarded, measured tone
+		return map[string]interface{}{
+			"stability":         0.45,
+			"similarity_boost":  0.75,
+			"style":             0.45,
+			"use_speaker_boost": true,
+		}
+	case EmotionRelieved:
+		// Softer, relaxed delivery
+		return map[string]interface{}{
+			"stability":         0.45,
+			"similarity_boost":  0.75,
+			"style":             0.4,
 			"use_speaker_boost": true,
 		}
 	default: // EmotionNeutral
 		return map[string]interface{}{
-			"stability":        0.50,
-			"similarity_boost": 0.75,
-			"style":            0.0,
+			"stability":         0.50,
+			"similarity_boost":  0.75,
+			"style":             0.0,
 			"use_speaker_boost": true,
 		}
 	}
 }
 
+// mergeSettings replaces voice settings with any overrides
+func mergeSettings(settings, overrides map[string]interface{}) map[string]interface{} {
+	for key, value := range overrides {
+		settings[key] = value
+	}
+	return settings
+}
+
 // TextToSpeech converts text to speech and returns the audio data
 func (c *Client) TextToSpeech(ctx context.Context, voiceID, text string) ([]byte, error) {
 	return c.TextToSpeechWithEmotion(ctx, voiceID, text, EmotionNeutral)
@@ -120,17 +217,24 @@ func (c *Client) TextToSpeech(ctx context.Context, voiceID, text string) ([]byte
 
 // TextToSpeechWithEmotion converts text to speech with specific emotional delivery
 func (c *Client) TextToSpeechWithEmotion(ctx context.Context, voiceID, text string, emotion EmotionType) ([]byte, error) {
+	return c.TextToSpeechWithSettings(ctx, voiceID, text, emotion, nil, "")
+}
+
+// TextToSpeechWithSettings converts text to speech with an emotion's voice
+// settings, replaced key by key with overrides. An empty outputFormat
+// returns DefaultOutputFormat.
+func (c *Client) TextToSpeechWithSettings(ctx context.Context, voiceID, text string, emotion EmotionType, overrides map[string]interface{}, outputFormat string) ([]byte, error) {
 	// If no API key, return nil (mock mode)
 	if c.apiKey == "" {
 		return nil, nil
 	}
 
-	url := fmt.Sprintf("%s/text-to-speech/%s", apiBaseURL, voiceID)
+	url := speechURL(voiceID, "", outputFormat)
 
 	reqBody := TextToSpeechRequest{
-		Text:          text,
-		ModelID:       "eleven_turbo_v2_5",
-		VoiceSettings: c.getVoiceSettings(emotion),
+		Text:          c.speakableText(ctx, text),
+		ModelID:       c.model,
+		VoiceSettings: mergeSettings(c.getVoiceSettings(emotion), overrides),
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -154,8 +258,9 @@ func (c *Client) TextToSpeechWithEmotion(ctx context.Context, voiceID, text stri
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("elevenlabs API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, &resilience.StatusError{Provider: "elevenlabs", StatusCode: resp.StatusCode, Message: string(body)}
 	}
+	recordSpeech(ctx, reqBody.Text)
 
 	audioData, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -168,20 +273,29 @@ func (c *Client) TextToSpeechWithEmotion(ctx context.Context, voiceID, text stri
 // TextToSpeechStream converts text to speech and returns a streaming reader
 // This is useful for streaming audio chunks in real-time
 func (c *Client) TextToSpeechStream(ctx context.Context, voiceID, text string) (io.ReadCloser, error) {
+	return c.TextToSpeechStreamWithEmotion(ctx, voiceID, text, EmotionNeutral)
+}
+
+// TextToSpeechStreamWithEmotion streams speech with specific emotional delivery
+func (c *Client) TextToSpeechStreamWithEmotion(ctx context.Context, voiceID, text string, emotion EmotionType) (io.ReadCloser, error) {
+	return c.TextToSpeechStreamWithSettings(ctx, voiceID, text, emotion, nil, "")
+}
+
+// TextToSpeechStreamWithSettings streams speech with an emotion's voice
+// settings, replaced key by key with overrides. An empty outputFormat
+// streams DefaultOutputFormat.
+func (c *Client) TextToSpeechStreamWithSettings(ctx context.Context, voiceID, text string, emotion EmotionType, overrides map[string]interface{}, outputFormat string) (io.ReadCloser, error) {
 	// If no API key, return nil (mock mode)
 	if c.apiKey == "" {
 		return nil, nil
 	}
 
-	url := fmt.Sprintf("%s/text-to-speech/%s/stream", apiBaseURL, voiceID)
+	url := speechURL(voiceID, "/stream", outputFormat)
 
 	reqBody := TextToSpeechRequest{
-		Text:    text,
-		ModelID: "eleven_turbo_v2_5",
-		VoiceSettings: map[string]interface{}{
-			"stability":        0.5,
-			"similarity_boost": 0.75,
-		},
+		Text:          c.speakableText(ctx, text),
+		ModelID:       c.model,
+		VoiceSettings: mergeSettings(c.getVoiceSettings(emotion), overrides),
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -205,8 +319,24 @@ func (c *Client) TextToSpeechStream(ctx context.Context, voiceID, text string) (
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		resp.Body.Close()
-		return nil, fmt.Errorf("elevenlabs API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, &resilience.StatusError{Provider: "elevenlabs", StatusCode: resp.StatusCode, Message: string(body)}
 	}
+	recordSpeech(ctx, reqBody.Text)
 
 	return resp.Body, nil
 }
+
+// recordSpeech records the characters a text-to-speech request is billed for
+func recordSpeech(ctx context.Context, text string) {
+	usage.Record(ctx, models.Usage{TTSCalls: 1, TTSCharacters: int64(utf8.RuneCountInString(text))})
+}
+
+// speechURL returns a text-to-speech endpoint for a voice, asking for an
+// output format such as "mp3_44100_64", "opus_48000_64" or "pcm_16000"
+func speechURL(voiceID, endpoint, outputFormat string) string {
+	u := fmt.Sprintf("%s/text-to-speech/%s%s", apiBaseURL, url.PathEscape(voiceID), endpoint)
+	if outputFormat != "" {
+		u += "?output_format=" + url.QueryEscape(outputFormat)
+	}
+	return u
+}
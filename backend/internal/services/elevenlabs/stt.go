@@ -0,0 +1,72 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+
+	"github.com/ttrubel/send-me-home/internal/resilience"
+)
+
+// speechToTextResponse is the part of the transcription response we use
+type speechToTextResponse struct {
+	Text string `json:"text"`
+}
+
+// SpeechToText transcribes recorded audio with ElevenLabs Scribe
+func (c *Client) SpeechToText(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	if c.apiKey == "" {
+		return "", fmt.Errorf("elevenlabs API key not configured")
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField("model_id", "scribe_v1"); err != nil {
+		return "", fmt.Errorf("failed to write request: %w", err)
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="question"`)
+	header.Set("Content-Type", mimeType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to write request: %w", err)
+	}
+	if _, err := part.Write(audio); err != nil {
+		return "", fmt.Errorf("failed to write request: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("failed to write request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", apiBaseURL+"/speech-to-text", &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("xi-api-key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(resp.Body)
+		return "", &resilience.StatusError{Provider: "elevenlabs", StatusCode: resp.StatusCode, Message: string(errBody)}
+	}
+
+	var result speechToTextResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Text, nil
+}
@@ -0,0 +1,173 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+)
+
+// Alignment gives the time each character of the text is spoken
+type Alignment struct {
+	Characters []string  `json:"characters"`
+	StartTimes []float64 `json:"character_start_times_seconds"`
+	EndTimes   []float64 `json:"character_end_times_seconds"`
+}
+
+// TimedSpeech is synthesized audio with its character alignment
+type TimedSpeech struct {
+	Audio     []byte
+	Alignment Alignment
+}
+
+// timestampsResponse is the with-timestamps API response
+type timestampsResponse struct {
+	AudioBase64 string     `json:"audio_base64"`
+	Alignment   *Alignment `json:"alignment"`
+}
+
+// TextToSpeechWithTimestamps converts text to speech like
+// TextToSpeechWithSettings and also returns when each character is spoken
+func (c *Client) TextToSpeechWithTimestamps(ctx context.Context, voiceID, text string, emotion EmotionType, overrides map[string]interface{}, outputFormat string) (*TimedSpeech, error) {
+	// If no API key, return nil (mock mode)
+	if c.apiKey == "" {
+		return nil, nil
+	}
+
+	url := speechURL(voiceID, "/with-timestamps", outputFormat)
+
+	spoken, subs := c.lexicon.Rewrite(text, lexicon.Pronunciations(ctx), SupportsPhonemes(c.model))
+	reqBody := TextToSpeechRequest{
+		Text:          spoken,
+		ModelID:       c.model,
+		VoiceSettings: mergeSettings(c.getVoiceSettings(emotion), overrides),
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("xi-api-key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &resilience.StatusError{Provider: "elevenlabs", StatusCode: resp.StatusCode, Message: string(body)}
+	}
+	recordSpeech(ctx, reqBody.Text)
+
+	var result timestampsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	audio, err := base64.StdEncoding.DecodeString(result.AudioBase64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode audio: %w", err)
+	}
+
+	speech := &TimedSpeech{Audio: audio}
+	if result.Alignment != nil {
+		speech.Alignment = originalAlignment(*result.Alignment, text, subs)
+	}
+	return speech, nil
+}
+
+// originalAlignment maps the alignment of the text sent, in which the
+// lexicon replaced some terms, back onto the original text. A replaced
+// term takes the time its replacement was spoken, spread evenly over its
+// characters. The alignment may cover the text sent with or without its
+// phoneme tags; if it matches neither, no timings are returned rather than
+// ones that don't fit the text.
+func originalAlignment(alignment Alignment, text string, subs []lexicon.Substitution) Alignment {
+	if len(subs) == 0 {
+		return alignment
+	}
+
+	// Timings per rune of the aligned text
+	var aligned []rune
+	var starts, ends []float64
+	for i, char := range alignment.Characters {
+		if i >= len(alignment.StartTimes) || i >= len(alignment.EndTimes) {
+			return Alignment{}
+		}
+		for _, r := range char {
+			aligned = append(aligned, r)
+			starts = append(starts, alignment.StartTimes[i])
+			ends = append(ends, alignment.EndTimes[i])
+		}
+	}
+
+	// Work out which form of each replacement was aligned
+	replacement := func(sub lexicon.Substitution) string { return sub.Spoken }
+	if sent(text, subs, replacement) != string(aligned) {
+		replacement = func(sub lexicon.Substitution) string { return sub.Plain }
+		if sent(text, subs, replacement) != string(aligned) {
+			return Alignment{}
+		}
+	}
+
+	var result Alignment
+	add := func(char string, start, end float64) {
+		result.Characters = append(result.Characters, char)
+		result.StartTimes = append(result.StartTimes, start)
+		result.EndTimes = append(result.EndTimes, end)
+	}
+
+	pos, last := 0, 0
+	for _, sub := range subs {
+		for _, r := range text[last:sub.Start] {
+			add(string(r), starts[pos], ends[pos])
+			pos++
+		}
+
+		term := []rune(text[sub.Start:sub.End])
+		n := utf8.RuneCountInString(replacement(sub))
+		from, to := starts[pos], ends[pos+n-1]
+		step := (to - from) / float64(len(term))
+		for k, r := range term {
+			add(string(r), from+step*float64(k), from+step*float64(k+1))
+		}
+		pos += n
+		last = sub.End
+	}
+	for _, r := range text[last:] {
+		add(string(r), starts[pos], ends[pos])
+		pos++
+	}
+
+	return result
+}
+
+// sent returns text with its substitutions replaced
+func sent(text string, subs []lexicon.Substitution, replacement func(lexicon.Substitution) string) string {
+	var b strings.Builder
+	last := 0
+	for _, sub := range subs {
+		b.WriteString(text[last:sub.Start])
+		b.WriteString(replacement(sub))
+		last = sub.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
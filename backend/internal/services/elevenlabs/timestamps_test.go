@@ -0,0 +1,117 @@
+package elevenlabs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// alignmentOf times each character of text a tenth of a second apart
+func alignmentOf(text string) Alignment {
+	var a Alignment
+	for i, r := range []rune(text) {
+		a.Characters = append(a.Characters, string(r))
+		a.StartTimes = append(a.StartTimes, float64(i)/10)
+		a.EndTimes = append(a.EndTimes, float64(i+1)/10)
+	}
+	return a
+}
+
+func TestOriginalAlignment(t *testing.T) {
+	lex := &lexicon.Lexicon{}
+	extra := []models.Pronunciation{
+		{Term: "Delta-7", Alias: "Delta Seven"},
+		{Term: "Ceres", Alias: "Seer-eez", Phoneme: "ˈsɪəriːz"},
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		phonemes bool
+		aligned  func(spoken string, subs []lexicon.Substitution) string // Text the provider aligned
+		wantOK   bool
+	}{
+		{
+			name:    "aliases",
+			text:    "Delta-7 to Ceres, over.",
+			aligned: func(spoken string, _ []lexicon.Substitution) string { return spoken },
+			wantOK:  true,
+		},
+		{
+			name:     "phoneme tags aligned as sent",
+			text:     "Back to Ceres now.",
+			phonemes: true,
+			aligned:  func(spoken string, _ []lexicon.Substitution) string { return spoken },
+			wantOK:   true,
+		},
+		{
+			name:     "phoneme tags stripped by the provider",
+			text:     "Back to Ceres now.",
+			phonemes: true,
+			aligned:  func(string, []lexicon.Substitution) string { return "Back to Ceres now." },
+			wantOK:   true,
+		},
+		{
+			name:    "alignment of other text",
+			text:    "Delta-7 again.",
+			aligned: func(string, []lexicon.Substitution) string { return "something else" },
+			wantOK:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spoken, subs := lex.Rewrite(tt.text, extra, tt.phonemes)
+			if len(subs) == 0 {
+				t.Fatal("expected substitutions")
+			}
+
+			got := originalAlignment(alignmentOf(tt.aligned(spoken, subs)), tt.text, subs)
+			if !tt.wantOK {
+				if len(got.Characters) != 0 {
+					t.Fatalf("expected no timings, got %q", strings.Join(got.Characters, ""))
+				}
+				return
+			}
+
+			if text := strings.Join(got.Characters, ""); text != tt.text {
+				t.Fatalf("characters = %q, want %q", text, tt.text)
+			}
+			for i := range got.Characters {
+				if got.EndTimes[i] < got.StartTimes[i] || (i > 0 && got.StartTimes[i] < got.StartTimes[i-1]) {
+					t.Fatalf("timings out of order at %d: %v %v", i, got.StartTimes, got.EndTimes)
+				}
+			}
+		})
+	}
+}
+
+func TestOriginalAlignmentSpreadsReplacedTerm(t *testing.T) {
+	lex := &lexicon.Lexicon{}
+	extra := []models.Pronunciation{{Term: "AI", Alias: "A I"}}
+
+	spoken, subs := lex.Rewrite("AI ok", extra, false)
+	got := originalAlignment(alignmentOf(spoken), "AI ok", subs)
+
+	// "A I" takes 0.0-0.3s, so each letter of "AI" gets half of it; the
+	// rest of the line keeps its own timings, shifted past the alias
+	want := Alignment{
+		Characters: []string{"A", "I", " ", "o", "k"},
+		StartTimes: []float64{0, 0.15, 0.3, 0.4, 0.5},
+		EndTimes:   []float64{0.15, 0.3, 0.4, 0.5, 0.6},
+	}
+	if !reflect.DeepEqual(got.Characters, want.Characters) {
+		t.Fatalf("characters = %q, want %q", got.Characters, want.Characters)
+	}
+	for i := range want.StartTimes {
+		if !near(got.StartTimes[i], want.StartTimes[i]) || !near(got.EndTimes[i], want.EndTimes[i]) {
+			t.Fatalf("timings = %v %v, want %v %v", got.StartTimes, got.EndTimes, want.StartTimes, want.EndTimes)
+		}
+	}
+}
+
+func near(a, b float64) bool {
+	return a-b < 1e-9 && b-a < 1e-9
+}
@@ -0,0 +1,43 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Voiced lines are kept out of the session document, which would
+	// otherwise outgrow Firestore's size limit over a shift
+	audioCollection = "audio"
+)
+
+// SaveAudio stores voiced audio under a session and returns the reference
+// to read it back with
+func (c *Client) SaveAudio(ctx context.Context, sessionID, name string, audio models.StoredAudio) (string, error) {
+	ref := c.client.Collection(sessionsCollection).Doc(sessionID).Collection(audioCollection).Doc(name)
+	if _, err := ref.Set(ctx, audio); err != nil {
+		return "", fmt.Errorf("failed to save audio: %w", err)
+	}
+	return name, nil
+}
+
+// GetAudio reads audio stored under a session with SaveAudio
+func (c *Client) GetAudio(ctx context.Context, sessionID, ref string) (*models.StoredAudio, error) {
+	doc, err := c.client.Collection(sessionsCollection).Doc(sessionID).Collection(audioCollection).Doc(ref).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("audio not found: %s", ref)
+		}
+		return nil, fmt.Errorf("failed to get audio: %w", err)
+	}
+
+	var audio models.StoredAudio
+	if err := doc.DataTo(&audio); err != nil {
+		return nil, fmt.Errorf("failed to parse audio data: %w", err)
+	}
+	return &audio, nil
+}
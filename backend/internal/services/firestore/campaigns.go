@@ -0,0 +1,75 @@
+package firestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	campaignsCollection = "campaigns"
+)
+
+// ErrCampaignMoved is returned when another session advanced the campaign
+// since it was loaded
+var ErrCampaignMoved = errors.New("campaign advanced by another session")
+
+// GetCampaign retrieves a player's campaign, or nil if they have not started one
+func (c *Client) GetCampaign(ctx context.Context, playerID string) (*models.Campaign, error) {
+	doc, err := c.client.Collection(campaignsCollection).Doc(playerID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get campaign: %w", err)
+	}
+
+	var campaign models.Campaign
+	if err := doc.DataTo(&campaign); err != nil {
+		return nil, fmt.Errorf("failed to parse campaign data: %w", err)
+	}
+
+	return &campaign, nil
+}
+
+// StartCampaignDay saves a campaign session and the campaign advanced to
+// its day, provided the stored campaign is still on fromDay. Sessions
+// started at the same time would otherwise both claim the next day.
+func (c *Client) StartCampaignDay(ctx context.Context, campaign *models.Campaign, fromDay int, session *models.Session) error {
+	campaignRef := c.client.Collection(campaignsCollection).Doc(campaign.PlayerID)
+	sessionRef := c.client.Collection(sessionsCollection).Doc(session.SessionID)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		day := 0
+		doc, err := tx.Get(campaignRef)
+		switch {
+		case status.Code(err) == codes.NotFound:
+		case err != nil:
+			return err
+		default:
+			var stored models.Campaign
+			if err := doc.DataTo(&stored); err != nil {
+				return fmt.Errorf("failed to parse campaign data: %w", err)
+			}
+			day = stored.Day
+		}
+		if day != fromDay {
+			return ErrCampaignMoved
+		}
+
+		if err := tx.Set(sessionRef, session); err != nil {
+			return err
+		}
+		return tx.Set(campaignRef, campaign)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to start campaign day: %w", err)
+	}
+	return nil
+}
@@ -3,15 +3,20 @@ package firestore
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 const (
 	sessionsCollection = "sessions"
+
+	// maxListedSessions caps how many sessions are listed per player
+	maxListedSessions = 50
 )
 
 // Client handles session storage with Firestore
@@ -68,6 +73,64 @@ func (c *Client) GetSession(ctx context.Context, sessionID string) (*models.Sess
 	return &session, nil
 }
 
+// ListSessions returns a player's sessions, newest first
+func (c *Client) ListSessions(ctx context.Context, playerID string) ([]models.Session, error) {
+	iter := c.client.Collection(sessionsCollection).
+		Where("PlayerID", "==", playerID).
+		OrderBy("ShiftStartedAt", firestore.Desc).
+		Limit(maxListedSessions).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var sessions []models.Session
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+
+		var session models.Session
+		if err := doc.DataTo(&session); err != nil {
+			return nil, fmt.Errorf("failed to parse session data: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// WaitForCase blocks until the session has a case at index, returning the
+// session, or until ctx is done
+func (c *Client) WaitForCase(ctx context.Context, sessionID string, index int) (*models.Session, error) {
+	iter := c.client.Collection(sessionsCollection).Doc(sessionID).Snapshots(ctx)
+	defer iter.Stop()
+
+	for {
+		snap, err := iter.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("failed to watch session: %w", err)
+		}
+		if !snap.Exists() {
+			return nil, fmt.Errorf("session not found: %s", sessionID)
+		}
+
+		var session models.Session
+		if err := snap.DataTo(&session); err != nil {
+			return nil, fmt.Errorf("failed to parse session data: %w", err)
+		}
+
+		if index < len(session.Cases) {
+			return &session, nil
+		}
+	}
+}
+
 // UpdateSession updates an existing session
 func (c *Client) UpdateSession(ctx context.Context, session *models.Session) error {
 	// Check if session exists first
@@ -101,8 +164,8 @@ func (c *Client) GetCase(ctx context.Context, sessionID, caseID string) (*models
 	return nil, fmt.Errorf("case not found: %s", caseID)
 }
 
-// IncrementCaseIndex moves to the next case
-func (c *Client) IncrementCaseIndex(ctx context.Context, sessionID string) error {
+// UseSecondaryCheck decrements the secondary check quota and counts the check against the case
+func (c *Client) UseSecondaryCheck(ctx context.Context, sessionID, caseID string) error {
 	docRef := c.client.Collection(sessionsCollection).Doc(sessionID)
 
 	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
@@ -119,25 +182,38 @@ func (c *Client) IncrementCaseIndex(ctx context.Context, sessionID string) error
 			return fmt.Errorf("failed to parse session data: %w", err)
 		}
 
-		// Always increment to track completed cases
-		// CurrentCaseIndex will equal len(session.Cases) when all cases are done
-		session.CurrentCaseIndex++
+		if session.RemainingSecondaryChecks <= 0 {
+			return fmt.Errorf("no secondary checks remaining")
+		}
+
+		session.RemainingSecondaryChecks--
+
+		for i := range session.Cases {
+			if session.Cases[i].CaseID == caseID {
+				session.Cases[i].SecondaryChecksUsed++
+				break
+			}
+		}
 
 		return tx.Set(docRef, session)
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to increment case index: %w", err)
+		return fmt.Errorf("failed to use secondary check: %w", err)
 	}
 
 	return nil
 }
 
-// UpdateScore updates the session score
-func (c *Client) UpdateScore(ctx context.Context, sessionID string, scoreDelta int, correct bool) error {
+// AppendCase adds a lazily generated case to the session at the given index.
+// It returns false if another request already filled that slot.
+func (c *Client) AppendCase(ctx context.Context, sessionID string, index int, caseData models.Case) (bool, error) {
 	docRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+	appended := false
 
 	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		appended = false
+
 		doc, err := tx.Get(docRef)
 		if err != nil {
 			if status.Code(err) == codes.NotFound {
@@ -151,27 +227,28 @@ func (c *Client) UpdateScore(ctx context.Context, sessionID string, scoreDelta i
 			return fmt.Errorf("failed to parse session data: %w", err)
 		}
 
-		session.Score += scoreDelta
-
-		if correct {
-			session.CorrectDecisions++
-		} else {
-			session.IncorrectDecisions++
+		if len(session.Cases) != index {
+			return nil
 		}
 
+		session.Cases = append(session.Cases, caseData)
+		appended = true
+
 		return tx.Set(docRef, session)
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to update score: %w", err)
+		return false, fmt.Errorf("failed to append case: %w", err)
 	}
 
-	return nil
+	return appended, nil
 }
 
-// UseSecondaryCheck decrements the secondary check quota
-func (c *Client) UseSecondaryCheck(ctx context.Context, sessionID string) error {
+// MarkCaseServed records when a case was first shown to the player and
+// returns that time. Serving a case again keeps the original time.
+func (c *Client) MarkCaseServed(ctx context.Context, sessionID, caseID string, at time.Time) (time.Time, error) {
 	docRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+	servedAt := at
 
 	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
 		doc, err := tx.Get(docRef)
@@ -187,17 +264,146 @@ func (c *Client) UseSecondaryCheck(ctx context.Context, sessionID string) error
 			return fmt.Errorf("failed to parse session data: %w", err)
 		}
 
-		if session.RemainingSecondaryChecks <= 0 {
-			return fmt.Errorf("no secondary checks remaining")
+		for i := range session.Cases {
+			if session.Cases[i].CaseID != caseID {
+				continue
+			}
+			if !session.Cases[i].ServedAt.IsZero() {
+				servedAt = session.Cases[i].ServedAt
+				return nil
+			}
+			session.Cases[i].ServedAt = at
+			servedAt = at
+			return tx.Set(docRef, session)
 		}
 
-		session.RemainingSecondaryChecks--
+		return fmt.Errorf("case not found: %s", caseID)
+	})
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to mark case served: %w", err)
+	}
+
+	return servedAt, nil
+}
+
+// ResolveCase atomically records the player's decision on a case and
+// updates the session score. score fills in the resolution's score from the
+// session as read in the transaction, so that quick successive decisions
+// see each other's streak and citations. If the case was already resolved,
+// the stored resolution is returned unchanged and recorded is false.
+func (c *Client) ResolveCase(ctx context.Context, sessionID string, resolution models.Resolution, score func(session *models.Session, r *models.Resolution)) (*models.Resolution, bool, error) {
+	docRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+	result := resolution
+	recorded := false
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(docRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return fmt.Errorf("session not found: %s", sessionID)
+			}
+			return err
+		}
+
+		var session models.Session
+		if err := doc.DataTo(&session); err != nil {
+			return fmt.Errorf("failed to parse session data: %w", err)
+		}
+
+		// Retried or concurrent decisions keep the first resolution
+		if existing := session.Resolution(resolution.CaseID); existing != nil {
+			result = *existing
+			recorded = false
+			return nil
+		}
+
+		result = resolution
+		if score != nil {
+			score(&session, &result)
+		}
+		if err := session.Resolve(&result); err != nil {
+			return err
+		}
+		recorded = true
 
 		return tx.Set(docRef, session)
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to use secondary check: %w", err)
+		return nil, false, fmt.Errorf("failed to resolve case: %w", err)
+	}
+
+	return &result, recorded, nil
+}
+
+// StoreReactionAudio saves where the voiced reaction of a resolved case is
+// stored, its subtitles and whether voicing it failed
+func (c *Client) StoreReactionAudio(ctx context.Context, sessionID string, resolution models.Resolution) error {
+	docRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(docRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return fmt.Errorf("session not found: %s", sessionID)
+			}
+			return err
+		}
+
+		var session models.Session
+		if err := doc.DataTo(&session); err != nil {
+			return fmt.Errorf("failed to parse session data: %w", err)
+		}
+
+		stored := session.Resolution(resolution.CaseID)
+		if stored == nil {
+			return fmt.Errorf("case not resolved: %s", resolution.CaseID)
+		}
+		stored.ReactionAudioRef = resolution.ReactionAudioRef
+		stored.ReactionAudioFormat = resolution.ReactionAudioFormat
+		stored.ReactionSubtitles = resolution.ReactionSubtitles
+		stored.Degraded = resolution.Degraded
+		return tx.Set(docRef, session)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to store reaction audio: %w", err)
+	}
+
+	return nil
+}
+
+// RecordDialogue appends a question and the NPC's answer to a case transcript
+func (c *Client) RecordDialogue(ctx context.Context, sessionID, caseID string, line models.DialogueLine) error {
+	docRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(docRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return fmt.Errorf("session not found: %s", sessionID)
+			}
+			return err
+		}
+
+		var session models.Session
+		if err := doc.DataTo(&session); err != nil {
+			return fmt.Errorf("failed to parse session data: %w", err)
+		}
+
+		for i := range session.Cases {
+			if session.Cases[i].CaseID == caseID {
+				session.Cases[i].Transcript = append(session.Cases[i].Transcript, line)
+				return tx.Set(docRef, session)
+			}
+		}
+
+		return fmt.Errorf("case not found: %s", caseID)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to record dialogue: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,143 @@
+package firestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	jobsCollection = "generation_jobs"
+)
+
+// ErrJobCancelled is returned when progress is reported for a cancelled job
+var ErrJobCancelled = errors.New("generation job cancelled")
+
+// SaveJob stores a generation job
+func (c *Client) SaveJob(ctx context.Context, job *models.GenerationJob) error {
+	_, err := c.client.Collection(jobsCollection).Doc(job.JobID).Set(ctx, job)
+	if err != nil {
+		return fmt.Errorf("failed to save job: %w", err)
+	}
+	return nil
+}
+
+// GetJob retrieves a generation job by ID
+func (c *Client) GetJob(ctx context.Context, jobID string) (*models.GenerationJob, error) {
+	doc, err := c.client.Collection(jobsCollection).Doc(jobID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("job not found: %s", jobID)
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	var job models.GenerationJob
+	if err := doc.DataTo(&job); err != nil {
+		return nil, fmt.Errorf("failed to parse job data: %w", err)
+	}
+
+	return &job, nil
+}
+
+// UpdateJobProgress records a running job's progress. It returns
+// ErrJobCancelled once the job has been cancelled, so workers on any
+// instance stop generating.
+func (c *Client) UpdateJobProgress(ctx context.Context, jobID string, current, total int, message string) error {
+	return c.updateJob(ctx, jobID, func(job *models.GenerationJob) error {
+		if job.Status == models.JobCancelled {
+			return ErrJobCancelled
+		}
+		job.Current = current
+		job.Total = total
+		job.Message = message
+		return nil
+	})
+}
+
+// FinishJob marks a job ready, failed or cancelled. A job that already
+// finished keeps its first outcome, which is returned.
+func (c *Client) FinishJob(ctx context.Context, jobID string, jobStatus models.JobStatus, sessionID, errMessage string) (models.JobStatus, error) {
+	final := jobStatus
+	err := c.updateJob(ctx, jobID, func(job *models.GenerationJob) error {
+		if job.Finished() {
+			final = job.Status
+			return nil
+		}
+		job.Status = jobStatus
+		job.SessionID = sessionID
+		job.Error = errMessage
+		return nil
+	})
+	return final, err
+}
+
+func (c *Client) updateJob(ctx context.Context, jobID string, update func(job *models.GenerationJob) error) error {
+	docRef := c.client.Collection(jobsCollection).Doc(jobID)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(docRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return fmt.Errorf("job not found: %s", jobID)
+			}
+			return err
+		}
+
+		var job models.GenerationJob
+		if err := doc.DataTo(&job); err != nil {
+			return fmt.Errorf("failed to parse job data: %w", err)
+		}
+
+		if err := update(&job); err != nil {
+			return err
+		}
+		job.UpdatedAt = time.Now()
+
+		return tx.Set(docRef, job)
+	})
+
+	if err != nil {
+		if errors.Is(err, ErrJobCancelled) {
+			return ErrJobCancelled
+		}
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+
+	return nil
+}
+
+// WatchJob calls fn with every change to a job until fn returns false,
+// the job disappears or ctx is done
+func (c *Client) WatchJob(ctx context.Context, jobID string, fn func(job *models.GenerationJob) bool) error {
+	iter := c.client.Collection(jobsCollection).Doc(jobID).Snapshots(ctx)
+	defer iter.Stop()
+
+	for {
+		snap, err := iter.Next()
+		if err != nil {
+			if status.Code(err) == codes.Canceled || ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to watch job: %w", err)
+		}
+		if !snap.Exists() {
+			return fmt.Errorf("job not found: %s", jobID)
+		}
+
+		var job models.GenerationJob
+		if err := snap.DataTo(&job); err != nil {
+			return fmt.Errorf("failed to parse job data: %w", err)
+		}
+
+		if !fn(&job) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	npcsCollection = "npcs"
+
+	// maxRosterSize caps how many recurring workers are loaded per player
+	maxRosterSize = 200
+)
+
+// ListNPCs returns a player's NPC roster
+func (c *Client) ListNPCs(ctx context.Context, playerID string) ([]models.RosterNPC, error) {
+	iter := c.client.Collection(npcsCollection).
+		Where("PlayerID", "==", playerID).
+		Limit(maxRosterSize).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var npcs []models.RosterNPC
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list npcs: %w", err)
+		}
+
+		var npc models.RosterNPC
+		if err := doc.DataTo(&npc); err != nil {
+			return nil, fmt.Errorf("failed to parse npc data: %w", err)
+		}
+		npcs = append(npcs, npc)
+	}
+
+	return npcs, nil
+}
+
+// SaveNPC stores a roster NPC
+func (c *Client) SaveNPC(ctx context.Context, npc *models.RosterNPC) error {
+	_, err := c.client.Collection(npcsCollection).Doc(npc.NPCID).Set(ctx, npc)
+	if err != nil {
+		return fmt.Errorf("failed to save npc: %w", err)
+	}
+	return nil
+}
+
+// RecordEncounter appends an encounter to a roster NPC's memory
+func (c *Client) RecordEncounter(ctx context.Context, npcID string, encounter models.NPCEncounter) error {
+	docRef := c.client.Collection(npcsCollection).Doc(npcID)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(docRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return fmt.Errorf("npc not found: %s", npcID)
+			}
+			return err
+		}
+
+		var npc models.RosterNPC
+		if err := doc.DataTo(&npc); err != nil {
+			return fmt.Errorf("failed to parse npc data: %w", err)
+		}
+
+		// Retried resolutions must not duplicate the memory
+		for _, e := range npc.Encounters {
+			if e.SessionID == encounter.SessionID && e.CaseID == encounter.CaseID {
+				return nil
+			}
+		}
+
+		npc.Encounters = append(npc.Encounters, encounter)
+
+		return tx.Set(docRef, npc)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to record encounter: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/google/uuid"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	poolRuleSetsCollection = "case_pool_rulesets"
+	poolCasesCollection    = "case_pool"
+
+	// maxPoolRuleSets caps how many rule sets are considered when starting a session
+	maxPoolRuleSets = 100
+)
+
+// ListPoolRuleSets returns the rule sets in the case pool
+func (c *Client) ListPoolRuleSets(ctx context.Context) ([]models.PoolRuleSet, error) {
+	iter := c.client.Collection(poolRuleSetsCollection).
+		Limit(maxPoolRuleSets).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var ruleSets []models.PoolRuleSet
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pool rule sets: %w", err)
+		}
+
+		var ruleSet models.PoolRuleSet
+		if err := doc.DataTo(&ruleSet); err != nil {
+			return nil, fmt.Errorf("failed to parse pool rule set data: %w", err)
+		}
+		ruleSets = append(ruleSets, ruleSet)
+	}
+
+	return ruleSets, nil
+}
+
+// GetPoolRuleSet retrieves a pooled rule set, or nil if the pool has none for the key
+func (c *Client) GetPoolRuleSet(ctx context.Context, key string) (*models.PoolRuleSet, error) {
+	doc, err := c.client.Collection(poolRuleSetsCollection).Doc(key).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pool rule set: %w", err)
+	}
+
+	var ruleSet models.PoolRuleSet
+	if err := doc.DataTo(&ruleSet); err != nil {
+		return nil, fmt.Errorf("failed to parse pool rule set data: %w", err)
+	}
+
+	return &ruleSet, nil
+}
+
+// ListPooledCases returns up to limit pooled cases for a rule set and difficulty
+func (c *Client) ListPooledCases(ctx context.Context, ruleSetKey string, difficulty models.Difficulty, limit int) ([]models.PooledCase, error) {
+	iter := c.client.Collection(poolCasesCollection).
+		Where("RuleSetKey", "==", ruleSetKey).
+		Where("Difficulty", "==", difficulty).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var cases []models.PooledCase
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pooled cases: %w", err)
+		}
+
+		var pooled models.PooledCase
+		if err := doc.DataTo(&pooled); err != nil {
+			return nil, fmt.Errorf("failed to parse pooled case data: %w", err)
+		}
+		cases = append(cases, pooled)
+	}
+
+	return cases, nil
+}
+
+// AddPooledCases stores cases generated for gameDate in the pool under the
+// rule set key and updates the rule set's case count. The rule set is
+// created from rules and gameDate if it doesn't exist yet.
+func (c *Client) AddPooledCases(ctx context.Context, key string, rules []string, gameDate string, difficulty models.Difficulty, cases []models.Case) error {
+	if len(cases) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	docRef := c.client.Collection(poolRuleSetsCollection).Doc(key)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		ruleSet := models.PoolRuleSet{
+			Key:        key,
+			Rules:      rules,
+			GameDate:   gameDate,
+			CaseCounts: map[string]int{},
+			CreatedAt:  now,
+		}
+
+		doc, err := tx.Get(docRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if err := doc.DataTo(&ruleSet); err != nil {
+				return fmt.Errorf("failed to parse pool rule set data: %w", err)
+			}
+			if ruleSet.CaseCounts == nil {
+				ruleSet.CaseCounts = map[string]int{}
+			}
+		}
+
+		for _, caseData := range cases {
+			pooled := models.PooledCase{
+				PoolID:     uuid.New().String(),
+				RuleSetKey: key,
+				Difficulty: difficulty,
+				GameDate:   gameDate,
+				Case:       caseData,
+				CreatedAt:  now,
+			}
+			if err := tx.Set(c.client.Collection(poolCasesCollection).Doc(pooled.PoolID), pooled); err != nil {
+				return err
+			}
+		}
+		ruleSet.CaseCounts[string(difficulty)] += len(cases)
+
+		return tx.Set(docRef, ruleSet)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to add pooled cases: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+const (
+	// Budget counters, one document per budget, e.g. a player's day
+	spendingCollection = "spending"
+)
+
+// AddSpending adds to the spending counted under each key and returns the
+// totals after, including keys that were only read
+func (c *Client) AddSpending(ctx context.Context, deltas map[string]models.Spending) (map[string]models.Spending, error) {
+	if len(deltas) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(deltas))
+	refs := make([]*firestore.DocumentRef, 0, len(deltas))
+	for key := range deltas {
+		keys = append(keys, key)
+		refs = append(refs, c.client.Collection(spendingCollection).Doc(key))
+	}
+
+	var totals map[string]models.Spending
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		docs, err := tx.GetAll(refs)
+		if err != nil {
+			return err
+		}
+
+		totals = make(map[string]models.Spending, len(keys))
+		for i, doc := range docs {
+			total, err := spendingOf(doc)
+			if err != nil {
+				return err
+			}
+
+			delta := deltas[keys[i]]
+			total.Add(delta)
+			totals[keys[i]] = total
+			if delta == (models.Spending{}) {
+				continue
+			}
+			if err := tx.Set(refs[i], spendingIncrements(delta), firestore.MergeAll); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to add spending: %w", err)
+	}
+	return totals, nil
+}
+
+// AdmitSession counts a session under each key, unless the sessions counted
+// under one of them have reached its limit; a zero limit is unlimited. It
+// returns the index of the key that is at its limit, or -1 if the session
+// was counted.
+func (c *Client) AdmitSession(ctx context.Context, keys []string, limits []int64) (int, error) {
+	refs := make([]*firestore.DocumentRef, len(keys))
+	for i, key := range keys {
+		refs[i] = c.client.Collection(spendingCollection).Doc(key)
+	}
+
+	full := -1
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		docs, err := tx.GetAll(refs)
+		if err != nil {
+			return err
+		}
+
+		full = -1
+		for i, doc := range docs {
+			spent, err := spendingOf(doc)
+			if err != nil {
+				return err
+			}
+			if limits[i] > 0 && spent.Sessions >= limits[i] {
+				full = i
+				return nil
+			}
+		}
+
+		for _, ref := range refs {
+			if err := tx.Set(ref, spendingIncrements(models.Spending{Sessions: 1}), firestore.MergeAll); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return -1, fmt.Errorf("failed to admit session: %w", err)
+	}
+	return full, nil
+}
+
+// spendingOf reads a spending document; a missing one means nothing was spent
+func spendingOf(doc *firestore.DocumentSnapshot) (models.Spending, error) {
+	var s models.Spending
+	if !doc.Exists() {
+		return s, nil
+	}
+	if err := doc.DataTo(&s); err != nil {
+		return s, fmt.Errorf("failed to parse spending data: %w", err)
+	}
+	return s, nil
+}
+
+// spendingIncrements turns spending into field increments
+func spendingIncrements(s models.Spending) map[string]interface{} {
+	return map[string]interface{}{
+		"Tokens":        firestore.Increment(s.Tokens),
+		"TTSCharacters": firestore.Increment(s.TTSCharacters),
+		"Sessions":      firestore.Increment(s.Sessions),
+	}
+}
@@ -0,0 +1,120 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ttrubel/send-me-home/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	playerUsageCollection = "player_usage"
+
+	// Session usage lives in its own document so that whole-session writes
+	// can't overwrite concurrent increments
+	usageCollection = "usage"
+	usageDoc        = "totals"
+)
+
+// AddUsage adds to a session's usage and to the usage of the player who
+// owns the session
+func (c *Client) AddUsage(ctx context.Context, sessionID string, u models.Usage) error {
+	sessionRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+
+	err := c.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		session, err := sessionOwner(tx.Documents(c.ownerQuery(sessionRef)), sessionID)
+		if err != nil {
+			return err
+		}
+
+		increments := usageIncrements(u)
+		if err := tx.Set(sessionRef.Collection(usageCollection).Doc(usageDoc), increments, firestore.MergeAll); err != nil {
+			return err
+		}
+		if session.PlayerID == "" {
+			return nil
+		}
+		return tx.Set(c.client.Collection(playerUsageCollection).Doc(session.PlayerID), increments, firestore.MergeAll)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to add usage: %w", err)
+	}
+	return nil
+}
+
+// SessionOwner returns the ID of the player who owns a session, or "" if
+// nobody does
+func (c *Client) SessionOwner(ctx context.Context, sessionID string) (string, error) {
+	sessionRef := c.client.Collection(sessionsCollection).Doc(sessionID)
+	session, err := sessionOwner(c.ownerQuery(sessionRef).Documents(ctx), sessionID)
+	if err != nil {
+		return "", err
+	}
+	return session.PlayerID, nil
+}
+
+// ownerQuery reads only a session's owner; sessions carry audio and are large
+func (c *Client) ownerQuery(sessionRef *firestore.DocumentRef) firestore.Query {
+	return c.client.Collection(sessionsCollection).
+		Where(firestore.DocumentID, "==", sessionRef).
+		Select("PlayerID")
+}
+
+// sessionOwner reads the result of an owner query
+func sessionOwner(iter *firestore.DocumentIterator, sessionID string) (*models.Session, error) {
+	docs, err := iter.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("session not found: %s", sessionID)
+	}
+
+	var session models.Session
+	if err := docs[0].DataTo(&session); err != nil {
+		return nil, fmt.Errorf("failed to parse session data: %w", err)
+	}
+	return &session, nil
+}
+
+// GetSessionUsage returns a session's usage
+func (c *Client) GetSessionUsage(ctx context.Context, sessionID string) (models.Usage, error) {
+	return c.getUsage(ctx, c.client.Collection(sessionsCollection).Doc(sessionID).Collection(usageCollection).Doc(usageDoc))
+}
+
+// GetPlayerUsage returns a player's usage across all their sessions
+func (c *Client) GetPlayerUsage(ctx context.Context, playerID string) (models.Usage, error) {
+	return c.getUsage(ctx, c.client.Collection(playerUsageCollection).Doc(playerID))
+}
+
+// getUsage reads a usage document; a missing one means nothing was used
+func (c *Client) getUsage(ctx context.Context, ref *firestore.DocumentRef) (models.Usage, error) {
+	doc, err := ref.Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return models.Usage{}, nil
+		}
+		return models.Usage{}, fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	var u models.Usage
+	if err := doc.DataTo(&u); err != nil {
+		return models.Usage{}, fmt.Errorf("failed to parse usage data: %w", err)
+	}
+	return u, nil
+}
+
+// usageIncrements turns usage into field increments
+func usageIncrements(u models.Usage) map[string]interface{} {
+	return map[string]interface{}{
+		"LLMCalls":      firestore.Increment(u.LLMCalls),
+		"PromptTokens":  firestore.Increment(u.PromptTokens),
+		"OutputTokens":  firestore.Increment(u.OutputTokens),
+		"TTSCalls":      firestore.Increment(u.TTSCalls),
+		"TTSCharacters": firestore.Increment(u.TTSCharacters),
+	}
+}
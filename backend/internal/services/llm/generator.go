@@ -0,0 +1,839 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"strings"
+	"sync"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/services/tts"
+	"github.com/ttrubel/send-me-home/internal/voices"
+)
+
+// Generator implements ContentGenerator on top of any Completer, falling
+// back to mock content when the model is unavailable or misbehaves
+type Generator struct {
+	completer Completer
+	voices    *voices.Registry
+
+	mu   sync.Mutex
+	seed uint64 // Mock content is made from this
+}
+
+// NewGenerator creates a content generator that casts NPCs from the voice
+// registry; a nil completer always uses mock content. Mock content is
+// random unless seeded with WithSeed.
+func NewGenerator(completer Completer, voices *voices.Registry) *Generator {
+	g := &Generator{completer: completer, voices: voices}
+	return g.WithSeed(rand.Uint64())
+}
+
+// available reports whether a model can be used. If not, the caller falls
+// back to mock content, which is noted in ctx unless there is no model.
+func (g *Generator) available(ctx context.Context) bool {
+	if g.completer == nil {
+		return false
+	}
+	if g.completer.Available(ctx) {
+		return true
+	}
+	resilience.Fallback(ctx)
+	return false
+}
+
+// complete asks the model; a failed or empty reply makes the caller fall
+// back to mock content, which is noted in ctx
+func (g *Generator) complete(ctx context.Context, prompt string, temperature float32) (string, error) {
+	text, err := g.completer.Complete(ctx, prompt, temperature)
+	if err != nil {
+		log.Printf("Warning: Completion failed, using fallback content: %v", err)
+	}
+	if err != nil || text == "" {
+		resilience.Fallback(ctx)
+	}
+	return text, err
+}
+
+// GenerateRules generates daily rules for the shift.
+// previousRules holds yesterday's rules in a campaign; the new set keeps them
+// and adds or changes a single rule. Pass nil for a standalone shift.
+func (g *Generator) GenerateRules(ctx context.Context, gameDate string, previousRules []string) ([]string, error) {
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		return g.mockRules(gameDate, previousRules), nil
+	}
+
+	if len(previousRules) > 0 {
+		return g.evolveRules(ctx, gameDate, previousRules)
+	}
+
+	prompt := fmt.Sprintf(`You are generating rules for a Papers, Please-style game set on an asteroid mining station.
+
+TODAY'S GAME DATE: %s
+
+Generate 3-4 daily transit rules that workers must comply with to board the final departure shuttle.
+
+Workers have TWO documents:
+1. EMPLOYEE BADGE: name, picture URL, job title, issue date, expire date, company name
+2. CLEARANCE FORM: name, shift_status (one of: "COMPLETE", "INCOMPLETE", "OVERTIME"), cargo items (cargo1, cargo2)
+
+SHIFT STATUS (be clear):
+- "COMPLETE" = Worker finished their shift, can go home
+- "INCOMPLETE" = Worker didn't finish shift, should be denied
+- "OVERTIME" = Worker did extra hours (can still go home if rules allow)
+
+CARGO CATEGORIES (be very specific):
+- ALLOWED: "Personal clothing", "Family photos", "Toiletries", "Snacks", "Music player", "Books", "Personal tablet"
+- COMPANY PROPERTY (forbidden): "Delta-7 drill bit", "Company tablet", "Mining helmet", "Safety equipment", "Company radio", "Work tools"
+- CONTRABAND (forbidden): "Asteroid samples", "Ore samples", "Minerals", "Live specimens", "Alcohol", "Weapons"
+
+Rules should:
+- Be SHORT (one sentence max, under 60 characters ideal)
+- Be VERY SPECIFIC about what's allowed/forbidden
+- Create clear violations (no ambiguity)
+- Relate to: badge expiration (check against today's date %s), cargo restrictions, shift completion status
+
+Examples of good rules:
+- "Only COMPLETE shifts can board"
+- "No company tools leave the station"
+- "Expired badges = denied, no exceptions"
+- "Personal items only - no ore samples"
+- "INCOMPLETE shifts stay on station"
+
+Return ONLY a JSON array of strings, no other text:
+["rule 1", "rule 2", "rule 3", "rule 4"]`, gameDate, gameDate)
+
+	text, err := g.complete(ctx, prompt, 1.0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate rules: %w", err)
+	}
+
+	if text == "" {
+		return g.mockRules(gameDate, nil), nil
+	}
+
+	// Extract JSON from response
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "```json") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimSuffix(text, "```")
+		text = strings.TrimSpace(text)
+	}
+
+	var rules []string
+	if err := json.Unmarshal([]byte(text), &rules); err != nil {
+		return g.mockRules(gameDate, nil), nil
+	}
+
+	return rules, nil
+}
+
+// evolveRules builds today's campaign rules on top of yesterday's
+func (g *Generator) evolveRules(ctx context.Context, gameDate string, previousRules []string) ([]string, error) {
+	prompt := fmt.Sprintf(`You are updating the transit rules for the next day of a Papers, Please-style campaign set on an asteroid mining station.
+
+TODAY'S GAME DATE: %s
+
+YESTERDAY'S RULES:
+- %s
+
+Rules pile up from day to day. Keep yesterday's rules, then EITHER add ONE new rule OR tighten/change ONE existing rule.
+Never drop more than one rule. Keep the total between 3 and 7 rules.
+
+Workers carry an EMPLOYEE BADGE (name, job title, issue date, expire date, company name)
+and a CLEARANCE FORM (name, shift_status COMPLETE/INCOMPLETE/OVERTIME, cargo1, cargo2).
+New rules must be checkable from those documents only.
+
+Rules should be SHORT (one sentence, under 60 characters ideal) and unambiguous.
+
+Return ONLY a JSON array of strings, no other text:
+["rule 1", "rule 2", "rule 3", "rule 4"]`, gameDate, strings.Join(previousRules, "\n- "))
+
+	text, err := g.complete(ctx, prompt, 0.9)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evolve rules: %w", err)
+	}
+
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "```json") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimSuffix(text, "```")
+		text = strings.TrimSpace(text)
+	}
+
+	var rules []string
+	if err := json.Unmarshal([]byte(text), &rules); err != nil || len(rules) == 0 {
+		return g.mockRules(gameDate, previousRules), nil
+	}
+
+	return rules, nil
+}
+
+// GenerateStoryEvent writes the storyline event for a campaign day
+func (g *Generator) GenerateStoryEvent(ctx context.Context, day int, gameDate string, storyline []string, rules []string) (string, error) {
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		return mockStoryEvent(day), nil
+	}
+
+	history := "None yet - this is the first day."
+	if len(storyline) > 0 {
+		history = "- " + strings.Join(storyline, "\n- ")
+	}
+
+	prompt := fmt.Sprintf(`You are the narrator of a Papers, Please-style campaign on asteroid mining station Delta-7.
+The player is the transit clerk deciding who boards the last shuttle home each day.
+
+DAY: %d (game date %s)
+
+STORY SO FAR:
+%s
+
+TODAY'S RULES:
+- %s
+
+Write today's storyline event in ONE or TWO short sentences. It should follow from the story so far
+(e.g., a smuggling ring, a mine collapse, a strike, a corporate audit) and may explain today's rules.
+
+Your event:`, day, gameDate, history, strings.Join(rules, "\n- "))
+
+	text, err := g.complete(ctx, prompt, 1.0)
+	if err != nil {
+		return mockStoryEvent(day), nil
+	}
+
+	if text == "" {
+		return mockStoryEvent(day), nil
+	}
+
+	return strings.TrimSpace(text), nil
+}
+
+// GenerateCases generates multiple cases in parallel
+func (g *Generator) GenerateCases(ctx context.Context, caseReq models.CaseRequest) ([]models.Case, error) {
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		return g.mockCases(caseReq), nil
+	}
+
+	rules, count, gameDate := caseReq.Rules, caseReq.Count, caseReq.GameDate
+	rulesText := strings.Join(rules, "\n- ")
+
+	prompt := fmt.Sprintf(`You are generating cases for a Papers, Please-style document inspection game.
+
+TODAY'S GAME DATE: %s
+
+TODAY'S RULES:
+- %s
+
+Generate %d NPC worker cases. Each worker has TWO documents:
+1. EMPLOYEE BADGE: name, picture (MUST be exactly "USE_CASE_ID_AS_SEED"), job_title, issue_date, expire_date, company_name
+2. CLEARANCE FORM: name, shift_status (one of: "COMPLETE", "INCOMPLETE", "OVERTIME"), cargo1, cargo2
+
+SHIFT STATUS MUST BE CLEAR:
+- "COMPLETE" = Worker finished shift (can board if other rules pass)
+- "INCOMPLETE" = Shift not finished (violation if rules require complete)
+- "OVERTIME" = Extra hours worked (can board if rules allow)
+
+CARGO MUST BE CLEAR AND SPECIFIC:
+- ALLOWED: "Personal clothing", "Family photos", "Toiletries", "Snacks", "Music player", "Books", "Personal tablet", "Personal effects"
+- COMPANY PROPERTY (violation): "Delta-7 drill bit", "Company mining equipment", "Work helmet", "Safety vest", "Company radio", "Excavation tools"
+- CONTRABAND (violation): "Ore samples", "Mineral specimens", "Asteroid fragments", "Unauthorized samples"
+- DO NOT use ambiguous items like "Research equipment" or "Tools" - be SPECIFIC about whether personal or company
+
+NAME GENERATION - CRITICAL RULES:
+- Generate UNIQUE, DIVERSE names for EVERY worker - NO REPETITION across all cases
+- Use REALISTIC international names from varied cultures (Asian, African, European, Latin American, Middle Eastern, etc.)
+- BANNED names - NEVER use: "Elara", "Kael", "Zephyr", "Lyra", "Anya", "Priya Sharma", "Omar Hassan" (overused)
+- Mix cultural backgrounds: pair different ethnic first names with different ethnic last names
+- Use uncommon but realistic combinations to ensure variety
+- Think of actual real-world names you rarely see together
+- EVERY case MUST have a completely different name from all others
+
+Each case should have:
+1. An NPC profile (name, role, department, personality, demeanor, a one-sentence backstory, voice casting)
+2. The two documents above
+3. An opening line the NPC says
+4. The ground truth about this worker
+5. Whether they should be approved or denied
+6. List of contradictions (if any) between documents
+7. List of violations: the exact document fields that break a rule (empty if approved)
+
+About 60%% should be APPROVED (compliant with rules).
+About 40%% should be DENIED (violate at least one rule).
+
+DIFFICULTY:
+%s
+%s
+%s
+IMPORTANT DATE LOGIC:
+- Today's date is %s
+- Badge issue_date should be BEFORE today (e.g., 6 months ago)
+- Badge expire_date can be AFTER today (valid) or BEFORE today (expired - violation!)
+- Use the game date context to generate realistic dates
+
+Return ONLY valid JSON with this exact structure:
+{
+  "cases": [
+    {
+      "npc": {
+        "name": "Carlos Mendez",
+        "role": "Mining Engineer",
+        "department": "Excavation",
+        "personality": "tired",
+        "demeanor": "cooperative",
+        "backstory": "Third contract on Delta-7, sends every credit home to his daughter on Mars.",
+        "voice": {
+          "vocal_age": "middle_aged",
+          "pitch": "low",
+          "timbre": "rough",
+          "accent": "latin_american",
+          "energy": "low"
+        },
+        "pronunciations": [
+          {"term": "Mendez", "alias": "MEN-dez"}
+        ]
+      },
+      "documents": {
+        "employee_badge": {
+          "name": "Carlos Mendez",
+          "picture": "USE_CASE_ID_AS_SEED",
+          "job_title": "Mining Engineer",
+          "issue_date": "YYYY-MM-DD (must be before today)",
+          "expire_date": "YYYY-MM-DD (after today if valid, before if violation)",
+          "company_name": "Delta-7 Mining Corp"
+        },
+        "clearance_form": {
+          "name": "John Smith",
+          "shift_status": "COMPLETE",
+          "cargo1": "Personal effects",
+          "cargo2": "None"
+        }
+      },
+      "opening_line": "Hey, I need to catch the shuttle home. My shift's done.",
+      "truth": {
+        "employee_id": "EMP-1234",
+        "should_approve": true,
+        "reason": "Shift complete, badge valid, cargo approved"
+      },
+      "contradictions": [],
+      "violations": [],
+      "correct_decision": "approve"
+    }
+  ]
+}`, gameDate, rulesText, count, difficultyGuidance(caseReq.Difficulty), campaignGuidance(caseReq), g.castingGuidance(), gameDate)
+
+	text, err := g.complete(ctx, prompt, 1.0)
+	if err != nil {
+		return g.mockCases(caseReq), nil
+	}
+
+	if text == "" {
+		return g.mockCases(caseReq), nil
+	}
+
+	// Extract JSON from response
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "```json") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimSuffix(text, "```")
+		text = strings.TrimSpace(text)
+	}
+
+	var response struct {
+		Cases []struct {
+			NPC struct {
+				Name           string                 `json:"name"`
+				Role           string                 `json:"role"`
+				Department     string                 `json:"department"`
+				Personality    string                 `json:"personality"`
+				Demeanor       string                 `json:"demeanor"`
+				Backstory      string                 `json:"backstory"`
+				Voice          models.VoiceCasting    `json:"voice"`
+				Pronunciations []models.Pronunciation `json:"pronunciations"`
+			} `json:"npc"`
+			Documents struct {
+				EmployeeBadge map[string]string `json:"employee_badge"`
+				ClearanceForm map[string]string `json:"clearance_form"`
+			} `json:"documents"`
+			OpeningLine string `json:"opening_line"`
+			Truth       struct {
+				EmployeeID    string `json:"employee_id"`
+				ShouldApprove bool   `json:"should_approve"`
+				Reason        string `json:"reason"`
+			} `json:"truth"`
+			Contradictions []string `json:"contradictions"`
+			Violations     []struct {
+				Document string `json:"document"`
+				Field    string `json:"field"`
+				Rule     string `json:"rule"`
+			} `json:"violations"`
+			CorrectDecision string `json:"correct_decision"`
+		} `json:"cases"`
+	}
+
+	if err := json.Unmarshal([]byte(text), &response); err != nil {
+		return g.mockCases(caseReq), nil
+	}
+
+	// Convert to models.Case
+	cases := make([]models.Case, len(response.Cases))
+	for i, geminiCase := range response.Cases {
+		caseID := fmt.Sprintf("case-%d", caseReq.StartIndex+i+1)
+
+		// Fix badge picture URL to use caseID as seed
+		badgeFields := geminiCase.Documents.EmployeeBadge
+		if picture, ok := badgeFields["picture"]; ok && picture == "USE_CASE_ID_AS_SEED" {
+			badgeFields["picture"] = models.PortraitURL(caseID)
+		}
+
+		// Cast the voice that best fits how the character should sound
+		voiceID := g.voices.Cast(geminiCase.NPC.Voice, geminiCase.NPC.Name)
+
+		violations := make([]models.Violation, len(geminiCase.Violations))
+		for j, v := range geminiCase.Violations {
+			violations[j] = models.Violation{Document: v.Document, Field: v.Field, Rule: v.Rule}
+		}
+
+		cases[i] = models.Case{
+			CaseID: caseID,
+			NPC: models.NPCProfile{
+				Name:           geminiCase.NPC.Name,
+				Role:           geminiCase.NPC.Role,
+				Department:     geminiCase.NPC.Department,
+				Personality:    geminiCase.NPC.Personality,
+				VoiceID:        voiceID,
+				Demeanor:       geminiCase.NPC.Demeanor,
+				Backstory:      geminiCase.NPC.Backstory,
+				Casting:        geminiCase.NPC.Voice,
+				Pronunciations: geminiCase.NPC.Pronunciations,
+			},
+			Documents: []models.Document{
+				{Type: "employee_badge", Fields: badgeFields},
+				{Type: "clearance_form", Fields: geminiCase.Documents.ClearanceForm},
+			},
+			OpeningLine: geminiCase.OpeningLine,
+			Truth: models.CaseTruth{
+				EmployeeID:    geminiCase.Truth.EmployeeID,
+				ShouldApprove: geminiCase.Truth.ShouldApprove,
+				Reason:        geminiCase.Truth.Reason,
+			},
+			Contradictions:  geminiCase.Contradictions,
+			CorrectDecision: geminiCase.CorrectDecision,
+			Difficulty:      caseReq.Difficulty,
+			Violations:      violations,
+		}
+	}
+
+	return cases, nil
+}
+
+// castingGuidance tells the model how to describe each worker's voice
+func (g *Generator) castingGuidance() string {
+	timbres := "deep, raspy, rough, smooth, crisp, bright, soft, warm, strong, breathy"
+	if available := g.voices.Timbres(); len(available) > 0 {
+		timbres = strings.Join(available, ", ")
+	}
+
+	return fmt.Sprintf(`VOICE CASTING:
+Describe how each worker sounds so a voice actor can be cast. Base it on the character (age, job, personality, background), never on the name alone.
+- vocal_age: one of "young", "adult", "middle_aged", "elderly"
+- pitch: one of "low", "medium", "high"
+- timbre: one of %s
+- accent: the accent region, e.g. "american", "british", "australian", "south_asian", "west_african", "latin_american", "eastern_european"
+- energy: one of "low", "medium", "high"
+
+PRONUNCIATION:
+List under "pronunciations" any part of the worker's name a text-to-speech engine could get wrong, with an "alias" spelled the way it sounds in plain English (e.g. {"term": "Nguyen", "alias": "Win"}, {"term": "Siobhan", "alias": "Shi-VAWN"}). You may add an IPA "phoneme" too. Leave it empty for names that are easy to say.
+`, timbres)
+}
+
+// campaignGuidance describes the storyline and returning workers for campaign shifts
+func campaignGuidance(caseReq models.CaseRequest) string {
+	var b strings.Builder
+
+	if len(caseReq.Storyline) > 0 {
+		b.WriteString("\nCAMPAIGN STORYLINE (most recent last):\n")
+		for _, event := range caseReq.Storyline {
+			b.WriteString("- " + event + "\n")
+		}
+		b.WriteString("Let today's event color a few opening lines, moods and violations.\n")
+	}
+
+	if len(caseReq.ReturningNPCs) > 0 {
+		b.WriteString("\nRETURNING WORKERS - reuse these EXACT names, roles and departments for one case each (they are exempt from the no-repetition rule):\n")
+		for _, npc := range caseReq.ReturningNPCs {
+			b.WriteString(fmt.Sprintf("- %s (%s, %s)", npc.Name, npc.Role, npc.Department))
+			if npc.Backstory != "" {
+				b.WriteString(" - " + npc.Backstory)
+			}
+			b.WriteString("\n")
+			for _, event := range npc.History {
+				b.WriteString("    Last time: " + event + "\n")
+			}
+		}
+		b.WriteString("Returning workers remember the clerk: their opening line should reference what happened last time.\n")
+	}
+
+	return b.String()
+}
+
+// difficultyGuidance describes how obvious violations should be at a difficulty level
+func difficultyGuidance(difficulty models.Difficulty) string {
+	switch difficulty {
+	case models.DifficultyEasy:
+		return `The clerk is struggling. Make violations OBVIOUS:
+- Expired badges should have expired months or years ago
+- Forbidden cargo should be blatant (e.g., "Ore samples", "Company radio")
+- Names on both documents always match exactly
+- Keep at most ONE violation per denied worker`
+	case models.DifficultyHard:
+		return `The clerk is doing very well. Make violations SUBTLE:
+- Badges that expired only a day or two before today
+- Names that differ by a single letter between the badge and clearance form
+- Forbidden cargo placed in cargo2 behind an innocent cargo1
+- Confident, cooperative workers who are hiding something`
+	default:
+		return "Use a balanced mix of obvious and moderately subtle violations."
+	}
+}
+
+// GenerateDialogue generates NPC response to player question
+func (g *Generator) GenerateDialogue(ctx context.Context, dialogueCtx models.DialogueContext) (models.DialogueReply, error) {
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		if len(dialogueCtx.NPCProfile.History) > 0 {
+			return models.DialogueReply{
+				Text:    fmt.Sprintf("You again? Last time %s. About '%s'... let me explain.", lastEncounter(dialogueCtx.NPCProfile.History), dialogueCtx.Question),
+				Emotion: string(tts.EmotionSuspicious),
+			}, nil
+		}
+		return models.DialogueReply{
+			Text:    fmt.Sprintf("I understand your question about '%s'. Let me explain...", dialogueCtx.Question),
+			Emotion: string(tts.EmotionNeutral),
+		}, nil
+	}
+
+	prompt := fmt.Sprintf(`You are roleplaying an NPC worker at an asteroid mining station trying to board the final departure shuttle.
+
+YOUR CHARACTER:
+- Name: %s
+- Role: %s
+- Department: %s
+- Personality: %s
+- Demeanor: %s
+- Backstory: %s
+%s
+THE TRUTH (player doesn't know this):
+- Employee ID: %s
+- Should be approved: %t
+- Reason: %s
+
+THE PLAYER ASKED: "%s"
+
+Respond in character with 1-2 sentences. Be consistent with your personality and demeanor.
+If the question reveals information that would expose contradictions, be slightly evasive or defensive.
+If asked about something matching your documents, answer confidently.
+Never break character. Never mention "the truth" explicitly.
+If you have met this clerk before, you remember it - bring it up when it fits (e.g., "You denied me last week!").
+
+Start your response with the emotion it should be spoken with, in square brackets.
+Use one of: %s
+Example: [nervous] I... I finished my shift, I swear.
+
+Your response:`,
+		dialogueCtx.NPCProfile.Name,
+		dialogueCtx.NPCProfile.Role,
+		dialogueCtx.NPCProfile.Department,
+		dialogueCtx.NPCProfile.Personality,
+		dialogueCtx.NPCProfile.Demeanor,
+		dialogueCtx.NPCProfile.Backstory,
+		encounterHistory(dialogueCtx.NPCProfile.History),
+		dialogueCtx.CaseTruth.EmployeeID,
+		dialogueCtx.CaseTruth.ShouldApprove,
+		dialogueCtx.CaseTruth.Reason,
+		dialogueCtx.Question,
+		emotionList())
+
+	text, err := g.complete(ctx, prompt, 1.2)
+	if err != nil {
+		return models.DialogueReply{Text: "I... uh... what was the question again?", Emotion: string(tts.EmotionNervous)}, nil
+	}
+
+	if text == "" {
+		return models.DialogueReply{Text: "I'd rather not talk about that.", Emotion: string(tts.EmotionSuspicious)}, nil
+	}
+
+	return parseDialogueReply(text), nil
+}
+
+// emotionList names the emotions a reply can be tagged with
+func emotionList() string {
+	names := make([]string, len(tts.Emotions))
+	for i, e := range tts.Emotions {
+		names[i] = string(e)
+	}
+	return strings.Join(names, ", ")
+}
+
+// parseDialogueReply splits a leading [emotion] tag from a reply. Untagged
+// replies and unknown emotions are voiced neutrally.
+func parseDialogueReply(text string) models.DialogueReply {
+	text = strings.TrimSpace(text)
+
+	emotion := tts.EmotionNeutral
+	if strings.HasPrefix(text, "[") {
+		if end := strings.Index(text, "]"); end > 0 {
+			emotion = tts.ParseEmotion(text[1:end])
+			text = strings.TrimSpace(text[end+1:])
+		}
+	}
+
+	return models.DialogueReply{Text: text, Emotion: string(emotion)}
+}
+
+// encounterHistory lists a returning worker's past encounters for prompts
+func encounterHistory(history []string) string {
+	if len(history) == 0 {
+		return ""
+	}
+	return "\nYOUR HISTORY WITH THIS CLERK (you remember all of it):\n- " + strings.Join(history, "\n- ") + "\n"
+}
+
+// GenerateVerdict generates explanation of case outcome
+func (g *Generator) GenerateVerdict(ctx context.Context, caseData models.Case, playerDecision string) (string, error) {
+	correct := (playerDecision == caseData.CorrectDecision)
+
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		if correct {
+			return fmt.Sprintf("Correct! %s", caseData.Truth.Reason), nil
+		}
+		return fmt.Sprintf("Incorrect. You should have %s. %s", caseData.CorrectDecision, caseData.Truth.Reason), nil
+	}
+
+	contradictions := "None"
+	if len(caseData.Contradictions) > 0 {
+		contradictions = strings.Join(caseData.Contradictions, "; ")
+	}
+
+	prompt := fmt.Sprintf(`You are a transit supervisor evaluating a clerk's document inspection decision in a Papers, Please-style game.
+
+CASE DETAILS:
+- Worker: %s (%s)
+- Correct decision: %s
+- Clerk's decision: %s
+- Ground truth: %s
+- Contradictions: %s
+
+IMPORTANT - PERSPECTIVE:
+- Address the PLAYER (the clerk making the decision)
+- Provide feedback on the CLERK'S performance, NOT the worker's
+- DO NOT say things like "Worker good job" or praise the worker
+- DO say things like "Correct decision, clerk!" or "Wrong call!"
+
+Generate a 1-2 sentence verdict addressing the clerk.
+
+If correct: Praise the clerk and explain what they correctly identified (e.g., "Good catch, clerk! You correctly spotted the expired badge.")
+If incorrect: Tell the clerk they made a mistake and explain what they missed (e.g., "Wrong decision! You failed to notice their shift status was INCOMPLETE.")
+
+Be concise and professional like a transit supervisor evaluating their clerk.
+
+Your verdict:`,
+		caseData.NPC.Name,
+		caseData.NPC.Role,
+		caseData.CorrectDecision,
+		playerDecision,
+		caseData.Truth.Reason,
+		contradictions)
+
+	text, err := g.complete(ctx, prompt, 0.7)
+	if err != nil {
+		if correct {
+			return fmt.Sprintf("Correct! %s", caseData.Truth.Reason), nil
+		}
+		return fmt.Sprintf("Incorrect. You should have %s. %s", caseData.CorrectDecision, caseData.Truth.Reason), nil
+	}
+
+	if text == "" {
+		if correct {
+			return fmt.Sprintf("Correct! %s", caseData.Truth.Reason), nil
+		}
+		return fmt.Sprintf("Incorrect. You should have %s. %s", caseData.CorrectDecision, caseData.Truth.Reason), nil
+	}
+
+	return strings.TrimSpace(text), nil
+}
+
+// GenerateShiftSummary writes the supervisor's end-of-shift summary of the player's weak spots
+func (g *Generator) GenerateShiftSummary(ctx context.Context, report models.ShiftReport) (string, error) {
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		return mockShiftSummary(report), nil
+	}
+
+	var lines []string
+	for _, cr := range report.Cases {
+		decision := cr.Decision
+		if decision == "" {
+			decision = "undecided"
+		}
+		line := fmt.Sprintf("- %s: clerk %s, correct was %s, %d question(s), %d secondary check(s), %ds",
+			cr.NPCName, decision, cr.CorrectDecision, len(cr.QuestionsAsked), cr.SecondaryChecksUsed, int(cr.DecisionTime.Seconds()))
+		for _, v := range cr.MissedViolations {
+			line += fmt.Sprintf("; MISSED %s.%s (%s)", v.Document, v.Field, v.Rule)
+		}
+		lines = append(lines, line)
+	}
+
+	prompt := fmt.Sprintf(`You are a transit supervisor at an asteroid mining station writing an end-of-shift review for a document inspection clerk in a Papers, Please-style game.
+
+SHIFT %s:
+- Score: %d
+- Correct decisions: %d
+- Wrong decisions: %d
+- Citations: %d
+
+CASES:
+%s
+
+Write 2-4 sentences addressed to the clerk. Point out their weak spots: the kinds of violations they keep missing, whether they are too strict or too lenient, and whether they waste time or secondary checks.
+Be blunt and bureaucratic, like a supervisor who has seen too many shifts.
+
+Your review:`,
+		report.GameDate,
+		report.Score,
+		report.CorrectDecisions,
+		report.IncorrectDecisions,
+		report.Citations,
+		strings.Join(lines, "\n"))
+
+	text, err := g.complete(ctx, prompt, 0.7)
+	if err != nil {
+		return mockShiftSummary(report), nil
+	}
+
+	if text == "" {
+		return mockShiftSummary(report), nil
+	}
+
+	return strings.TrimSpace(text), nil
+}
+
+// mockShiftSummary summarizes the shift from the report counters
+func mockShiftSummary(report models.ShiftReport) string {
+	wrongApprovals, wrongDenials, missed := 0, 0, 0
+	for _, cr := range report.Cases {
+		missed += len(cr.MissedViolations)
+		if cr.Decision == "" || cr.Correct {
+			continue
+		}
+		if cr.Decision == "approve" {
+			wrongApprovals++
+		} else {
+			wrongDenials++
+		}
+	}
+
+	switch {
+	case wrongApprovals == 0 && wrongDenials == 0:
+		return "Clean shift, clerk. No complaints from upstairs. Don't get comfortable."
+	case wrongApprovals >= wrongDenials:
+		return fmt.Sprintf("You waved through %d worker(s) who should have stayed and missed %d violation(s). Read the documents, not the faces.", wrongApprovals, missed)
+	default:
+		return fmt.Sprintf("You turned away %d worker(s) with valid papers. Being strict is not the same as being right.", wrongDenials)
+	}
+}
+
+// GenerateNPCReaction generates worker's emotional response to approval/denial
+func (g *Generator) GenerateNPCReaction(ctx context.Context, caseData models.Case, playerDecision string, wasCorrect bool) (string, error) {
+	// Determine if worker is being approved or denied
+	approved := (playerDecision == "approve")
+
+	// Fallback to mock if no model is available
+	if !g.available(ctx) {
+		return g.mockReaction(approved, wasCorrect, caseData.NPC.Name), nil
+	}
+
+	// Build context about whether this was a fair decision
+	var emotionalContext string
+	if approved && wasCorrect {
+		emotionalContext = "You are being APPROVED and you deserve it (your documents are in order)."
+	} else if approved && !wasCorrect {
+		emotionalContext = "You are being APPROVED but you shouldn't be (you have violations, but the clerk missed them)."
+	} else if !approved && wasCorrect {
+		emotionalContext = "You are being DENIED and it's justified (you have violations)."
+	} else {
+		emotionalContext = "You are being DENIED unfairly (your documents are perfect, but the clerk made a mistake). You should be VERY ANGRY."
+	}
+
+	prompt := fmt.Sprintf(`You are an NPC worker at an asteroid mining station who just received a decision from the transit clerk.
+
+YOUR CHARACTER:
+- Name: %s
+- Role: %s
+- Personality: %s
+- Demeanor: %s
+
+SITUATION:
+%s
+
+Generate a SHORT (5-15 words max) emotional reaction.
+
+If APPROVED (fairly): Express relief, gratitude, thanks (e.g., "Thank you!", "Finally going home!", "Appreciate it, thanks!")
+If APPROVED (unfairly - you have violations): Act relieved but maybe slightly nervous/hurried (e.g., "Thanks! Gotta run!", "Oh, great! See ya!")
+If DENIED (fairly - you have violations): Express STRONG frustration and anger, but vary the intensity. Use profanity sparingly (e.g., "God DAMN it!", "Are you serious?!", "This is bullshit!", "You've got to be kidding me!", "Oh come ON!", "This is ridiculous!")
+If DENIED (unfairly - you're innocent): Express EXTREME RAGE with insults and occasional profanity. Attack the clerk's competence (e.g., "Are you KIDDING me?!", "What is WRONG with you?!", "Are you BLIND?!", "You incompetent fool!", "Learn to read!", "This is absolute garbage!", "You've got to be fucking joking!", "How did you even get this job?!")
+
+IMPORTANT: Workers are exhausted miners who get emotional. Use profanity occasionally (1 in 3 reactions), not in every line. Vary between clean anger and spicy outbursts.
+
+Your reaction:`,
+		caseData.NPC.Name,
+		caseData.NPC.Role,
+		caseData.NPC.Personality,
+		caseData.NPC.Demeanor,
+		emotionalContext)
+
+	text, err := g.complete(ctx, prompt, 1.3)
+	if err != nil {
+		return g.mockReaction(approved, wasCorrect, caseData.NPC.Name), nil
+	}
+
+	if text == "" {
+		return g.mockReaction(approved, wasCorrect, caseData.NPC.Name), nil
+	}
+
+	return strings.TrimSpace(text), nil
+}
+
+// Mock data functions (fallbacks)
+
+// lastEncounter returns what happened in the most recent encounter, without its date
+func lastEncounter(history []string) string {
+	last := history[len(history)-1]
+	if i := strings.Index(last, ": "); i >= 0 {
+		return last[i+2:]
+	}
+	return last
+}
+
+func mockStoryEvent(day int) string {
+	events := []string{
+		"The final shuttle of the season is docking. Everyone wants a seat.",
+		"Security found ore samples in a locker on Level 3. Management is furious.",
+		"A cave-in on the east shaft left two crews short-handed.",
+		"Corporate auditors arrive tomorrow. The supervisor wants zero mistakes.",
+		"Rumors spread about a smuggling ring using forged badges.",
+		"The miners' union threatens a strike over unpaid overtime.",
+		"A solar storm delayed the supply run. Tempers are short.",
+	}
+	if day <= 0 {
+		day = 1
+	}
+	return events[(day-1)%len(events)]
+}
@@ -0,0 +1,28 @@
+// Package llm generates game content with a language model
+package llm
+
+import (
+	"context"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// ContentGenerator produces rules, cases and dialogue for the game
+type ContentGenerator interface {
+	GenerateRules(ctx context.Context, gameDate string, previousRules []string) ([]string, error)
+	GenerateStoryEvent(ctx context.Context, day int, gameDate string, storyline []string, rules []string) (string, error)
+	GenerateCases(ctx context.Context, caseReq models.CaseRequest) ([]models.Case, error)
+	GenerateDialogue(ctx context.Context, dialogueCtx models.DialogueContext) (models.DialogueReply, error)
+	GenerateVerdict(ctx context.Context, caseData models.Case, playerDecision string) (string, error)
+	GenerateShiftSummary(ctx context.Context, report models.ShiftReport) (string, error)
+	GenerateNPCReaction(ctx context.Context, caseData models.Case, playerDecision string, wasCorrect bool) (string, error)
+}
+
+// Completer sends a single prompt to a model and returns its text reply
+type Completer interface {
+	// Available reports whether the model is configured and reachable
+	Available(ctx context.Context) bool
+
+	// Complete returns the model's reply to prompt
+	Complete(ctx context.Context, prompt string, temperature float32) (string, error)
+}
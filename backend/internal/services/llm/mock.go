@@ -0,0 +1,599 @@
+package llm
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// Mock content is reproducible from the generator's seed. Every draw comes
+// from the seed and the inputs of what is drawn, such as the game date and
+// yesterday's rules, so the same seed and inputs always give the same rules,
+// cases and reactions.
+
+// MockGameDate is the game date of a new shift in mock mode; a date that
+// moved with the clock would change the content
+const MockGameDate = "2125-06-01"
+
+// WithSeed makes mock content reproducible from seed
+func (g *Generator) WithSeed(seed uint64) *Generator {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.seed = seed
+	return g
+}
+
+// Seed returns the seed mock content is made from
+func (g *Generator) Seed() uint64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.seed
+}
+
+// mockRand returns a random source derived from the seed and parts, so that
+// the same inputs always give the same content
+func (g *Generator) mockRand(parts ...string) *rand.Rand {
+	return SeededRand(g.Seed(), parts...)
+}
+
+// SeededRand returns a random source derived from seed and parts
+func SeededRand(seed uint64, parts ...string) *rand.Rand {
+	h := fnv.New64a()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return rand.New(rand.NewPCG(seed, h.Sum64()))
+}
+
+var (
+	mockShiftRules = []string{
+		"Only COMPLETE shifts can board",
+		"INCOMPLETE shifts stay on station",
+		"OVERTIME workers need manager approval",
+		"All shifts must be COMPLETE to depart",
+	}
+
+	mockCargoRules = []string{
+		"No company equipment leaves the station",
+		"Personal items only - no ore samples",
+		"No company tools allowed on shuttle",
+		"Contraband items = instant denial",
+		"Only personal belongings permitted",
+	}
+
+	mockBadgeRules = []string{
+		"Expired badges get denied",
+		"Badge must be valid on departure date",
+		"No exceptions for expired credentials",
+		"Current badges only - check dates",
+	}
+
+	// mockExtraRules are added on later campaign days
+	mockExtraRules = []string{
+		"Badge and clearance names must match exactly",
+		"No more than one personal tablet per worker",
+		"Safety Officers must clear OVERTIME before leaving",
+		"Badges issued in the last week are not valid yet",
+	}
+
+	// mockDefaultRules stand in when a case is checked against rules the
+	// mock generator doesn't know
+	mockDefaultRules = []string{
+		"Only COMPLETE shifts can board",
+		"Personal items only - no ore samples",
+		"Expired badges get denied",
+	}
+)
+
+// ruleCheck is what a mock rule checks
+type ruleCheck int
+
+const (
+	checkShiftComplete  ruleCheck = iota // Shift must be COMPLETE
+	checkNotIncomplete                   // Shift must not be INCOMPLETE
+	checkNotOvertime                     // Shift must not be OVERTIME
+	checkSafetyOvertime                  // Safety Officers must not be on OVERTIME
+	checkNoCompanyProperty
+	checkNoContraband
+	checkPersonalOnly
+	checkOneTablet
+	checkNotExpired
+	checkCurrentBadge // Not expired and not issued in the future
+	checkNoNewBadge   // Not issued in the last week
+	checkNamesMatch
+)
+
+var mockRuleChecks = map[string]ruleCheck{
+	"Only COMPLETE shifts can board":                     checkShiftComplete,
+	"INCOMPLETE shifts stay on station":                  checkNotIncomplete,
+	"OVERTIME workers need manager approval":             checkNotOvertime,
+	"All shifts must be COMPLETE to depart":              checkShiftComplete,
+	"No company equipment leaves the station":            checkNoCompanyProperty,
+	"Personal items only - no ore samples":               checkPersonalOnly,
+	"No company tools allowed on shuttle":                checkNoCompanyProperty,
+	"Contraband items = instant denial":                  checkNoContraband,
+	"Only personal belongings permitted":                 checkPersonalOnly,
+	"Expired badges get denied":                          checkNotExpired,
+	"Badge must be valid on departure date":              checkNotExpired,
+	"No exceptions for expired credentials":              checkNotExpired,
+	"Current badges only - check dates":                  checkCurrentBadge,
+	"Badge and clearance names must match exactly":       checkNamesMatch,
+	"No more than one personal tablet per worker":        checkOneTablet,
+	"Safety Officers must clear OVERTIME before leaving": checkSafetyOvertime,
+	"Badges issued in the last week are not valid yet":   checkNoNewBadge,
+}
+
+var (
+	mockPersonalItems = []string{"Personal clothing", "Family photos", "Toiletries", "Snacks", "Music player", "Books", "Personal tablet"}
+	mockCompanyItems  = []string{"Delta-7 drill bit", "Company tablet", "Mining helmet", "Safety equipment", "Company radio", "Work tools"}
+	mockContraband    = []string{"Asteroid samples", "Ore samples", "Minerals", "Live specimens", "Alcohol", "Weapons"}
+	mockFirstNames    = []string{"Ada", "Boris", "Chen", "Dana", "Emeka", "Freya", "Gus", "Hana", "Ivan", "Jo", "Kofi", "Lena", "Mateo", "Nadia", "Omar", "Priya", "Quinn", "Rosa", "Sven", "Tamsin", "Umar", "Vera", "Wes", "Yuki"}
+	mockLastNames     = []string{"Okafor", "Lindqvist", "Park", "Moreau", "Kowalski", "Reyes", "Haddad", "Novak", "Brennan", "Tanaka", "Silva", "Petrov", "Osei", "Fischer", "Quispe", "Byrne"}
+	mockJobTitles     = []string{"Drill Operator", "Ore Processor", "Systems Tech", "Geologist", "Safety Officer", "Maintenance Tech", "Hauler Pilot", "Blast Technician"}
+	mockDepartments   = []string{"Mining Operations", "Ore Processing", "Engineering", "Life Support", "Logistics"}
+	mockPersonalities = []string{"tired", "anxious", "cheerful", "gruff", "talkative", "proud"}
+	mockDemeanors     = []string{"cooperative", "impatient", "nervous", "friendly", "defensive"}
+	mockBackstories   = []string{
+		"Signed a two-year contract to pay off family debts back on Earth.",
+		"Missed a sister's wedding for the last rotation and swore it was the last.",
+		"Came out for the hazard pay and stayed for the crew.",
+		"Has a kid starting school on Ceres next month.",
+		"Spent the last year on the night shift and hasn't seen the sun since.",
+	}
+	mockOpeningLines = []string{
+		"Hey, I need to catch the shuttle home. My shift's done.",
+		"Please tell me there's still a seat on that shuttle.",
+		"Papers are all here. Can we make this quick?",
+		"Two years on this rock. I'm going home today.",
+		"Evening, clerk. Long shift. Let's get this over with.",
+	}
+)
+
+// mockWorker is the document data of a mock case
+type mockWorker struct {
+	name          string // On the badge
+	clearanceName string // On the clearance form
+	role          string
+	status        string
+	cargo         [2]string
+	issued        time.Time
+	expires       time.Time
+}
+
+// violations returns the fields of w that break a rule
+func (w *mockWorker) violations(check ruleCheck, rule string, today time.Time) []models.Violation {
+	badge := func(field string) []models.Violation {
+		return []models.Violation{{Document: "employee_badge", Field: field, Rule: rule}}
+	}
+	clearance := func(field string) []models.Violation {
+		return []models.Violation{{Document: "clearance_form", Field: field, Rule: rule}}
+	}
+	cargo := func(forbidden func(string) bool) []models.Violation {
+		var v []models.Violation
+		for i, item := range w.cargo {
+			if forbidden(item) {
+				v = append(v, clearance(fmt.Sprintf("cargo%d", i+1))...)
+			}
+		}
+		return v
+	}
+
+	switch check {
+	case checkShiftComplete:
+		if w.status != "COMPLETE" {
+			return clearance("shift_status")
+		}
+	case checkNotIncomplete:
+		if w.status == "INCOMPLETE" {
+			return clearance("shift_status")
+		}
+	case checkNotOvertime:
+		if w.status == "OVERTIME" {
+			return clearance("shift_status")
+		}
+	case checkSafetyOvertime:
+		if w.role == "Safety Officer" && w.status == "OVERTIME" {
+			return clearance("shift_status")
+		}
+	case checkNoCompanyProperty:
+		return cargo(func(item string) bool { return slices.Contains(mockCompanyItems, item) })
+	case checkNoContraband:
+		return cargo(func(item string) bool { return slices.Contains(mockContraband, item) })
+	case checkPersonalOnly:
+		return cargo(func(item string) bool { return !slices.Contains(mockPersonalItems, item) })
+	case checkOneTablet:
+		if w.cargo[0] == "Personal tablet" && w.cargo[1] == "Personal tablet" {
+			return clearance("cargo2")
+		}
+	case checkNotExpired:
+		if w.expires.Before(today) {
+			return badge("expire_date")
+		}
+	case checkCurrentBadge:
+		if w.expires.Before(today) {
+			return badge("expire_date")
+		}
+		if w.issued.After(today) {
+			return badge("issue_date")
+		}
+	case checkNoNewBadge:
+		if w.issued.After(today.AddDate(0, 0, -7)) {
+			return badge("issue_date")
+		}
+	case checkNamesMatch:
+		if w.clearanceName != w.name {
+			return clearance("name")
+		}
+	}
+	return nil
+}
+
+// breakRule changes w so that it breaks a rule. Subtle changes are harder
+// to spot; blatant ones are easy.
+func (w *mockWorker) breakRule(r *rand.Rand, check ruleCheck, today time.Time, difficulty models.Difficulty) {
+	subtle := difficulty == models.DifficultyHard
+	slot := r.IntN(2)
+
+	switch check {
+	case checkShiftComplete:
+		w.status = pick(r, []string{"INCOMPLETE", "OVERTIME"})
+	case checkNotIncomplete:
+		w.status = "INCOMPLETE"
+	case checkNotOvertime:
+		w.status = "OVERTIME"
+	case checkSafetyOvertime:
+		w.role = "Safety Officer"
+		w.status = "OVERTIME"
+	case checkNoCompanyProperty:
+		w.cargo[slot] = pick(r, mockCompanyItems)
+	case checkNoContraband:
+		w.cargo[slot] = pick(r, mockContraband)
+	case checkPersonalOnly:
+		w.cargo[slot] = pick(r, append(slices.Clone(mockCompanyItems), mockContraband...))
+	case checkOneTablet:
+		w.cargo = [2]string{"Personal tablet", "Personal tablet"}
+	case checkNotExpired, checkCurrentBadge:
+		days := 30 + r.IntN(300)
+		if subtle {
+			days = 1 + r.IntN(3)
+		}
+		w.expires = today.AddDate(0, 0, -days)
+		w.issued = w.expires.AddDate(-1, 0, 0)
+	case checkNoNewBadge:
+		w.issued = today.AddDate(0, 0, -r.IntN(7))
+	case checkNamesMatch:
+		w.clearanceName = misspell(r, w.name, subtle)
+	}
+}
+
+// misspell returns a name that differs from name, by a swapped pair of
+// letters when subtle and by a different first name otherwise
+func misspell(r *rand.Rand, name string, subtle bool) string {
+	first, last, _ := strings.Cut(name, " ")
+	if !subtle || len(last) < 3 {
+		for {
+			if other := pick(r, mockFirstNames); other != first {
+				return other + " " + last
+			}
+		}
+	}
+
+	letters := []rune(last)
+	for i := 1 + r.IntN(len(letters)-2); i < len(letters)-1; i++ {
+		if letters[i] != letters[i+1] {
+			letters[i], letters[i+1] = letters[i+1], letters[i]
+			return first + " " + string(letters)
+		}
+	}
+	return first + " " + last + "e"
+}
+
+// describe says what is wrong with a violated field, for the case debrief
+func (w *mockWorker) describe(v models.Violation) string {
+	switch v.Field {
+	case "shift_status":
+		return fmt.Sprintf("Shift status is %s", w.status)
+	case "cargo1":
+		return fmt.Sprintf("Carrying %s", w.cargo[0])
+	case "cargo2":
+		return fmt.Sprintf("Carrying %s", w.cargo[1])
+	case "expire_date":
+		return fmt.Sprintf("Badge expired on %s", w.expires.Format("2006-01-02"))
+	case "issue_date":
+		return fmt.Sprintf("Badge issued on %s", w.issued.Format("2006-01-02"))
+	case "name":
+		return fmt.Sprintf("Clearance form is made out to %s, badge to %s", w.clearanceName, w.name)
+	default:
+		return v.Rule
+	}
+}
+
+func pick[T any](r *rand.Rand, items []T) T {
+	return items[r.IntN(len(items))]
+}
+
+// mockRules picks a standalone shift's rules, or adds one rule to
+// yesterday's on a campaign day
+func (g *Generator) mockRules(gameDate string, previousRules []string) []string {
+	r := g.mockRand("rules", gameDate, strings.Join(previousRules, "\n"))
+
+	// Campaign days keep yesterday's rules and add one new rule
+	if len(previousRules) > 0 {
+		rules := append([]string{}, previousRules...)
+		extraRules := slices.Concat(mockExtraRules, mockShiftRules, mockCargoRules, mockBadgeRules)
+		for _, offset := range r.Perm(len(extraRules)) {
+			if !slices.Contains(rules, extraRules[offset]) {
+				return append(rules, extraRules[offset])
+			}
+		}
+		return rules
+	}
+
+	// Pick 1-2 shift rules
+	rules := []string{pick(r, mockShiftRules)}
+	if r.Float32() < 0.5 {
+		secondShift := pick(r, mockShiftRules)
+		if secondShift != rules[0] {
+			rules = append(rules, secondShift)
+		}
+	}
+
+	// Pick 1 cargo rule and 1 badge rule
+	rules = append(rules, pick(r, mockCargoRules))
+	rules = append(rules, pick(r, mockBadgeRules))
+
+	return rules
+}
+
+func (g *Generator) mockCases(caseReq models.CaseRequest) []models.Case {
+	cases := make([]models.Case, caseReq.Count)
+	for i := 0; i < caseReq.Count; i++ {
+		// Bring returning workers back in the first few cases
+		var returning *models.NPCProfile
+		if i < len(caseReq.ReturningNPCs) {
+			returning = &caseReq.ReturningNPCs[i]
+		}
+
+		cases[i] = g.generateMockCase(caseReq, caseReq.StartIndex+i+1, returning)
+		cases[i].Difficulty = caseReq.Difficulty
+		cases[i].Mock = true
+	}
+	return cases
+}
+
+// mockCastings gives mock workers a spread of voices
+var mockCastings = []models.VoiceCasting{
+	{VocalAge: "adult", Pitch: "low", Timbre: "deep", Accent: "american", Energy: "low"},
+	{VocalAge: "young", Pitch: "high", Timbre: "bright", Accent: "american", Energy: "medium"},
+	{VocalAge: "elderly", Pitch: "low", Timbre: "crisp", Accent: "american", Energy: "medium"},
+	{VocalAge: "middle_aged", Pitch: "medium", Timbre: "soft", Accent: "american", Energy: "low"},
+	{VocalAge: "young", Pitch: "medium", Timbre: "raspy", Accent: "american", Energy: "high"},
+	{VocalAge: "adult", Pitch: "high", Timbre: "breathy", Accent: "american_southern", Energy: "low"},
+	{VocalAge: "adult", Pitch: "medium", Timbre: "rough", Accent: "american", Energy: "medium"},
+}
+
+// generateMockCase builds a case whose correct decision follows from the
+// rules: the worker is denied exactly when their documents break one of
+// them. Rules the mock generator doesn't know are replaced with defaults.
+func (g *Generator) generateMockCase(caseReq models.CaseRequest, index int, returning *models.NPCProfile) models.Case {
+	r := g.mockRand("case", strings.Join(caseReq.Rules, "\n"), string(caseReq.Difficulty), strconv.Itoa(index))
+
+	today, err := time.Parse("2006-01-02", caseReq.GameDate)
+	if err != nil {
+		// Fallback to the mock date if parsing fails
+		today, _ = time.Parse("2006-01-02", MockGameDate)
+	}
+
+	var rules []string
+	for _, rule := range caseReq.Rules {
+		if _, ok := mockRuleChecks[rule]; ok {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		rules = mockDefaultRules
+	}
+
+	// Start from a worker who breaks no rule
+	name := pick(r, mockFirstNames) + " " + pick(r, mockLastNames)
+	if returning != nil {
+		name = returning.Name
+	}
+	firstItem := r.IntN(len(mockPersonalItems))
+	secondItem := (firstItem + 1 + r.IntN(len(mockPersonalItems)-1)) % len(mockPersonalItems)
+	issued := today.AddDate(0, 0, -(30 + r.IntN(700)))
+	worker := &mockWorker{
+		name:          name,
+		clearanceName: name,
+		role:          pick(r, mockJobTitles),
+		status:        "COMPLETE",
+		cargo:         [2]string{mockPersonalItems[firstItem], mockPersonalItems[secondItem]},
+		issued:        issued,
+		expires:       issued.AddDate(2, 0, 0),
+	}
+	if returning != nil && returning.Role != "" {
+		worker.role = returning.Role
+	}
+
+	// Overtime is fine unless a rule says otherwise
+	if r.IntN(4) == 0 {
+		overtime := *worker
+		overtime.status = "OVERTIME"
+		if len(checkRules(&overtime, rules, today)) == 0 {
+			worker.status = "OVERTIME"
+		}
+	}
+
+	// About half the workers break a rule; easy shifts have fewer forgeries
+	denyChance := 0.5
+	if caseReq.Difficulty == models.DifficultyEasy {
+		denyChance = 0.4
+	}
+	if r.Float64() < denyChance {
+		rule := pick(r, rules)
+		worker.breakRule(r, mockRuleChecks[rule], today, caseReq.Difficulty)
+	}
+
+	violations := checkRules(worker, rules, today)
+	shouldApprove := len(violations) == 0
+	reason := "Documents in order under today's rules"
+	contradictions := []string{}
+	var broken []string
+	for _, v := range violations {
+		if c := worker.describe(v); !slices.Contains(contradictions, c) {
+			contradictions = append(contradictions, c)
+		}
+		if !slices.Contains(broken, v.Rule) {
+			broken = append(broken, v.Rule)
+		}
+	}
+	if !shouldApprove {
+		reason = "Breaks: " + strings.Join(broken, "; ")
+	}
+
+	correctDecision := "approve"
+	if !shouldApprove {
+		correctDecision = "deny"
+	}
+
+	caseID := fmt.Sprintf("case-%d", index)
+	npc := models.NPCProfile{
+		Name:        name,
+		Role:        worker.role,
+		Department:  pick(r, mockDepartments),
+		Personality: pick(r, mockPersonalities),
+		Demeanor:    pick(r, mockDemeanors),
+		Backstory:   pick(r, mockBackstories),
+		Casting:     pick(r, mockCastings),
+	}
+	openingLine := pick(r, mockOpeningLines)
+	if returning != nil {
+		npc.Department = returning.Department
+		npc.Backstory = returning.Backstory
+		npc.VoiceID = returning.VoiceID
+		npc.Casting = returning.Casting
+		npc.Pronunciations = returning.Pronunciations
+		if len(returning.History) > 0 {
+			openingLine = fmt.Sprintf("Remember me? Last time %s.", lastEncounter(returning.History))
+		}
+	}
+	if npc.VoiceID == "" {
+		npc.VoiceID = g.voices.Cast(npc.Casting, name)
+	}
+
+	return models.Case{
+		CaseID: caseID,
+		NPC:    npc,
+		Documents: []models.Document{
+			{
+				Type: "employee_badge",
+				Fields: map[string]string{
+					"name":         worker.name,
+					"picture":      models.PortraitURL(caseID),
+					"job_title":    worker.role,
+					"issue_date":   worker.issued.Format("2006-01-02"),
+					"expire_date":  worker.expires.Format("2006-01-02"),
+					"company_name": "Delta-7 Mining Corp",
+				},
+			},
+			{
+				Type: "clearance_form",
+				Fields: map[string]string{
+					"name":         worker.clearanceName,
+					"shift_status": worker.status,
+					"cargo1":       worker.cargo[0],
+					"cargo2":       worker.cargo[1],
+				},
+			},
+		},
+		OpeningLine: openingLine,
+		Truth: models.CaseTruth{
+			EmployeeID:      fmt.Sprintf("EMP-%04d", r.IntN(10000)),
+			ActualTermEnd:   worker.expires.Format("2006-01-02"),
+			ActualClearance: "A",
+			ShouldApprove:   shouldApprove,
+			Reason:          reason,
+		},
+		Contradictions:  contradictions,
+		CorrectDecision: correctDecision,
+		Violations:      violations,
+	}
+}
+
+// checkRules returns every violation of the rules by w
+func checkRules(w *mockWorker, rules []string, today time.Time) []models.Violation {
+	var violations []models.Violation
+	for _, rule := range rules {
+		violations = append(violations, w.violations(mockRuleChecks[rule], rule, today)...)
+	}
+	return violations
+}
+
+func (g *Generator) mockReaction(approved bool, wasCorrect bool, npcName string) string {
+	r := g.mockRand("reaction", npcName, strconv.FormatBool(approved), strconv.FormatBool(wasCorrect))
+
+	if approved && wasCorrect {
+		// Thank you messages for fair approval
+		return pick(r, []string{
+			"Thank you!",
+			"Finally going home!",
+			"Appreciate it, thanks!",
+			"Oh thank god, finally.",
+			"Great, thanks!",
+		})
+	} else if approved && !wasCorrect {
+		// Got away with violations
+		return pick(r, []string{
+			"Thanks! Gotta run!",
+			"Oh, great! See ya!",
+			"Uh, thanks...",
+			"Cool, cool. Thanks!",
+		})
+	} else if !approved && wasCorrect {
+		// Fair denial (has violations) - Mix of clean and spicy
+		return pick(r, []string{
+			"God DAMN it!",
+			"Are you serious?!",
+			"This is bullshit!",
+			"You've got to be kidding me!",
+			"Oh come ON!",
+			"This is ridiculous!",
+			"Damn it all!",
+			"Are you for real?!",
+			"Unbelievable!",
+			"What the hell?!",
+			"This is such crap!",
+			"You've gotta be joking!",
+		})
+	}
+
+	// Unfair denial (innocent worker) - Strong but varied intensity
+	return pick(r, []string{
+		"Are you KIDDING me?!",
+		"What is WRONG with you?!",
+		"Are you BLIND?!",
+		"You incompetent fool!",
+		"Learn to read!",
+		"This is absolute garbage!",
+		"You've got to be fucking joking!",
+		"How did you even get this job?!",
+		"Are you SERIOUS right now?!",
+		"What kind of idiot are you?!",
+		"Do you even know how to read?!",
+		"This is completely insane!",
+		"You're absolutely useless!",
+		"Check your damn eyes!",
+		"Are you out of your mind?!",
+		"This is a joke, right?!",
+		"You incompetent clown!",
+		"What the hell is wrong with you?!",
+	})
+}
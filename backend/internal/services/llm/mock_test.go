@@ -0,0 +1,164 @@
+package llm
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/voices"
+)
+
+// mockSession is the content of a mock campaign's first two days
+type mockSession struct {
+	Rules     [2][]string
+	Cases     []models.Case
+	Reactions []string
+}
+
+// buildMockSession generates a session the way the handler does, from a
+// generator with no model
+func buildMockSession(t *testing.T, seed uint64) mockSession {
+	t.Helper()
+
+	registry, err := voices.Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewGenerator(nil, registry).WithSeed(seed)
+	ctx := context.Background()
+
+	var s mockSession
+	gameDate := MockGameDate
+	for day := range s.Rules {
+		var previous []string
+		if day > 0 {
+			previous = s.Rules[day-1]
+		}
+		rules, err := g.GenerateRules(ctx, gameDate, previous)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Rules[day] = rules
+
+		for _, difficulty := range []models.Difficulty{models.DifficultyEasy, models.DifficultyNormal, models.DifficultyHard} {
+			cases, err := g.GenerateCases(ctx, models.CaseRequest{
+				Rules:      rules,
+				GameDate:   gameDate,
+				Count:      6,
+				StartIndex: len(s.Cases),
+				Difficulty: difficulty,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.Cases = append(s.Cases, cases...)
+		}
+		gameDate = nextDay(t, gameDate)
+	}
+
+	for i, c := range s.Cases {
+		reaction, err := g.GenerateNPCReaction(ctx, c, []string{"approve", "deny"}[i%2], i%3 != 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Reactions = append(s.Reactions, reaction)
+	}
+	return s
+}
+
+func nextDay(t *testing.T, date string) string {
+	t.Helper()
+	d, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d.AddDate(0, 0, 1).Format("2006-01-02")
+}
+
+func TestMockSessionsReproducible(t *testing.T) {
+	first := buildMockSession(t, 42)
+	second := buildMockSession(t, 42)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("sessions with the same seed differ")
+	}
+
+	if other := buildMockSession(t, 43); reflect.DeepEqual(first.Cases, other.Cases) {
+		t.Error("sessions with different seeds are identical")
+	}
+
+	// Campaign days build on the day before
+	if len(first.Rules[1]) != len(first.Rules[0])+1 || !slices.Equal(first.Rules[1][:len(first.Rules[0])], first.Rules[0]) {
+		t.Errorf("second day's rules %q don't extend the first's %q", first.Rules[1], first.Rules[0])
+	}
+}
+
+// workerOf reads a mock case's documents back into a worker
+func workerOf(t *testing.T, c models.Case) *mockWorker {
+	t.Helper()
+
+	fields := make(map[string]map[string]string)
+	for _, d := range c.Documents {
+		fields[d.Type] = d.Fields
+	}
+	badge, clearance := fields["employee_badge"], fields["clearance_form"]
+
+	date := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatalf("%s: bad date %q", c.CaseID, s)
+		}
+		return d
+	}
+	return &mockWorker{
+		name:          badge["name"],
+		clearanceName: clearance["name"],
+		role:          badge["job_title"],
+		status:        clearance["shift_status"],
+		cargo:         [2]string{clearance["cargo1"], clearance["cargo2"]},
+		issued:        date(badge["issue_date"]),
+		expires:       date(badge["expire_date"]),
+	}
+}
+
+func TestMockCasesFollowRules(t *testing.T) {
+	denied := 0
+	total := 0
+	for seed := uint64(1); seed <= 20; seed++ {
+		s := buildMockSession(t, seed)
+		for i, c := range s.Cases {
+			// Each day's cases come in three batches of six
+			day := i / 18
+			rules := s.Rules[day]
+			today, _ := time.Parse("2006-01-02", MockGameDate)
+			today = today.AddDate(0, 0, day)
+
+			// The documents break exactly the rules the labels say
+			want := checkRules(workerOf(t, c), rules, today)
+			if !slices.Equal(c.Violations, want) {
+				t.Fatalf("seed %d, %s: violations %+v, documents break %+v", seed, c.CaseID, c.Violations, want)
+			}
+
+			wantDecision := "approve"
+			if len(want) > 0 {
+				wantDecision = "deny"
+				denied++
+			}
+			if c.CorrectDecision != wantDecision || c.Truth.ShouldApprove != (wantDecision == "approve") {
+				t.Fatalf("seed %d, %s: decision %s (approve %v), want %s", seed, c.CaseID, c.CorrectDecision, c.Truth.ShouldApprove, wantDecision)
+			}
+			for _, v := range c.Violations {
+				if !slices.Contains(rules, v.Rule) {
+					t.Fatalf("seed %d, %s: violation of %q, which isn't one of today's rules", seed, c.CaseID, v.Rule)
+				}
+			}
+			total++
+		}
+	}
+
+	if denied == 0 || denied == total {
+		t.Errorf("%d of %d workers denied, want a mix", denied, total)
+	}
+}
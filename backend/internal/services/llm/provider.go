@@ -0,0 +1,73 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/services/gemini"
+	"github.com/ttrubel/send-me-home/internal/services/openai"
+	"github.com/ttrubel/send-me-home/internal/voices"
+)
+
+// NewCompleter returns the completer for the configured LLM provider, or nil
+// in mock content mode
+func NewCompleter(cfg *config.Config) (Completer, error) {
+	switch cfg.ContentMode {
+	case "", "live":
+	case "mock":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown content mode: %s", cfg.ContentMode)
+	}
+
+	switch cfg.LLMProvider {
+	case "", "gemini":
+		// Gemini reads its config from environment variables:
+		// - GOOGLE_GENAI_USE_VERTEXAI=true for Vertex AI
+		// - GOOGLE_CLOUD_PROJECT and GOOGLE_CLOUD_LOCATION for Vertex AI
+		// - GOOGLE_API_KEY for AI Studio
+		return guard(cfg, "gemini", gemini.NewClient()), nil
+	case "openai":
+		return guard(cfg, "openai", openai.NewClient(cfg.OpenAIBaseURL, cfg.OpenAIAPIKey, cfg.OpenAIModel)), nil
+	default:
+		return nil, fmt.Errorf("unknown LLM provider: %s", cfg.LLMProvider)
+	}
+}
+
+// NewContentGenerator creates a generator seeded for mock content from the
+// configuration
+func NewContentGenerator(cfg *config.Config, completer Completer, voices *voices.Registry) *Generator {
+	g := NewGenerator(completer, voices).WithSeed(uint64(cfg.MockSeed))
+	if cfg.ContentMode == "mock" {
+		log.Printf("Mock content mode: generating offline content with seed %d", g.Seed())
+	}
+	return g
+}
+
+// guard retries a provider's failed completions and stops calling it while
+// its circuit is open
+func guard(cfg *config.Config, provider string, c Completer) Completer {
+	policy := resilience.ConfiguredPolicy(cfg, cfg.LLMCallTimeout)
+	return &guardedCompleter{
+		Completer: c,
+		guard:     resilience.NewGuard(provider, policy, resilience.ConfiguredBreaker(cfg, provider)),
+	}
+}
+
+type guardedCompleter struct {
+	Completer
+	guard *resilience.Guard
+}
+
+func (c *guardedCompleter) Available(ctx context.Context) bool {
+	return !c.guard.Open() && c.Completer.Available(ctx)
+}
+
+func (c *guardedCompleter) Complete(ctx context.Context, prompt string, temperature float32) (string, error) {
+	return resilience.Call(ctx, c.guard, func(ctx context.Context) (string, error) {
+		return c.Completer.Complete(ctx, prompt, temperature)
+	})
+}
@@ -0,0 +1,115 @@
+// Package openai completes prompts with any OpenAI-compatible chat endpoint,
+// such as a local llama.cpp or Ollama server
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/usage"
+)
+
+// Client completes prompts with an OpenAI-compatible chat completions API
+type Client struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the API at baseURL (e.g. http://localhost:11434/v1).
+// apiKey may be empty for local servers.
+func NewClient(baseURL, apiKey, model string) *Client {
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		model:   model,
+		// Local models can be slow to answer long prompts
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float32       `json:"temperature"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int64 `json:"prompt_tokens"`
+		CompletionTokens int64 `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// Available reports whether an endpoint is configured
+func (c *Client) Available(ctx context.Context) bool {
+	return c.baseURL != ""
+}
+
+// Complete sends a prompt as a single user message and returns the reply text
+func (c *Client) Complete(ctx context.Context, prompt string, temperature float32) (string, error) {
+	if c.baseURL == "" {
+		return "", fmt.Errorf("openai base URL not configured")
+	}
+
+	body, err := json.Marshal(chatRequest{
+		Model:       c.model,
+		Messages:    []chatMessage{{Role: "user", Content: prompt}},
+		Temperature: temperature,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(resp.Body)
+		return "", &resilience.StatusError{Provider: "openai", StatusCode: resp.StatusCode, Message: string(errBody)}
+	}
+
+	var chatResp chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	usage.Record(ctx, models.Usage{
+		LLMCalls:     1,
+		PromptTokens: chatResp.Usage.PromptTokens,
+		OutputTokens: chatResp.Usage.CompletionTokens,
+	})
+	if len(chatResp.Choices) == 0 {
+		return "", fmt.Errorf("chat completion returned no choices")
+	}
+
+	return chatResp.Choices[0].Message.Content, nil
+}
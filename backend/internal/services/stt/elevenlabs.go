@@ -0,0 +1,22 @@
+package stt
+
+import (
+	"context"
+
+	"github.com/ttrubel/send-me-home/internal/services/elevenlabs"
+)
+
+// ElevenLabs transcribes speech with the ElevenLabs API
+type ElevenLabs struct {
+	client *elevenlabs.Client
+}
+
+// NewElevenLabs creates a transcriber backed by an ElevenLabs client
+func NewElevenLabs(client *elevenlabs.Client) *ElevenLabs {
+	return &ElevenLabs{client: client}
+}
+
+// Transcribe returns the words spoken in audio
+func (e *ElevenLabs) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	return e.client.SpeechToText(ctx, audio, mimeType)
+}
@@ -0,0 +1,51 @@
+package stt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+)
+
+// Local transcribes without network access. With a command it runs an
+// offline engine such as whisper.cpp, which gets the audio on stdin and
+// must write the transcript to stdout; {mime_type} in its arguments is
+// replaced. Without a command it stands in for a real engine by treating
+// UTF-8 "audio" as the transcript, so tests can speak by sending text.
+type Local struct {
+	command []string
+}
+
+// NewLocal creates a local transcriber; an empty command echoes text audio
+func NewLocal(command string) *Local {
+	return &Local{command: strings.Fields(command)}
+}
+
+// Transcribe runs the local command or echoes text audio
+func (l *Local) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	if len(l.command) == 0 {
+		if !utf8.Valid(audio) {
+			return "", fmt.Errorf("local transcriber without a command only accepts text audio")
+		}
+		return strings.TrimSpace(string(audio)), nil
+	}
+
+	args := make([]string, len(l.command)-1)
+	for i, arg := range l.command[1:] {
+		args[i] = strings.ReplaceAll(arg, "{mime_type}", mimeType)
+	}
+
+	cmd := exec.CommandContext(ctx, l.command[0], args...)
+	cmd.Stdin = bytes.NewReader(audio)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("stt command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
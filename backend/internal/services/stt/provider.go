@@ -0,0 +1,56 @@
+package stt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/services/elevenlabs"
+	"github.com/ttrubel/send-me-home/internal/services/gemini"
+)
+
+// NewTranscriber returns the transcriber for the configured STT provider
+func NewTranscriber(cfg *config.Config) (Transcriber, error) {
+	switch cfg.STTProvider {
+	case "", "elevenlabs":
+		if cfg.ElevenLabsAPIKey == "" {
+			return unavailable{}, nil
+		}
+		return guard(cfg, "elevenlabs", NewElevenLabs(elevenlabs.NewClient(cfg.ElevenLabsAPIKey, cfg.ElevenLabsModel))), nil
+	case "gemini":
+		return guard(cfg, "gemini", gemini.NewClient()), nil
+	case "local":
+		return NewLocal(cfg.STTCommand), nil
+	default:
+		return nil, fmt.Errorf("unknown STT provider: %s", cfg.STTProvider)
+	}
+}
+
+// guard retries a provider's failed transcriptions and stops calling it
+// while its circuit is open
+func guard(cfg *config.Config, provider string, t Transcriber) Transcriber {
+	policy := resilience.ConfiguredPolicy(cfg, cfg.SpeechCallTimeout)
+	return &guardedTranscriber{
+		transcriber: t,
+		guard:       resilience.NewGuard(provider, policy, resilience.ConfiguredBreaker(cfg, provider)),
+	}
+}
+
+type guardedTranscriber struct {
+	transcriber Transcriber
+	guard       *resilience.Guard
+}
+
+func (t *guardedTranscriber) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	return resilience.Call(ctx, t.guard, func(ctx context.Context) (string, error) {
+		return t.transcriber.Transcribe(ctx, audio, mimeType)
+	})
+}
+
+// unavailable rejects every transcription
+type unavailable struct{}
+
+func (unavailable) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	return "", ErrNotConfigured
+}
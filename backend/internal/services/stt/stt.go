@@ -0,0 +1,16 @@
+// Package stt turns the player's spoken questions into text
+package stt
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotConfigured is returned when no speech-to-text backend is set up
+var ErrNotConfigured = errors.New("speech-to-text is not configured")
+
+// Transcriber turns recorded audio into text
+type Transcriber interface {
+	// Transcribe returns the words spoken in audio of the given MIME type
+	Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error)
+}
@@ -0,0 +1,98 @@
+package tts
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+	"github.com/ttrubel/send-me-home/internal/services/elevenlabs"
+	"github.com/ttrubel/send-me-home/internal/voices"
+)
+
+// ElevenLabs synthesizes speech with the ElevenLabs API, applying the
+// emotion profiles from the voice registry
+type ElevenLabs struct {
+	client *elevenlabs.Client
+	voices *voices.Registry
+}
+
+// NewElevenLabs creates a synthesizer backed by an ElevenLabs client
+func NewElevenLabs(client *elevenlabs.Client, voices *voices.Registry) *ElevenLabs {
+	return &ElevenLabs{client: client, voices: voices}
+}
+
+// Synthesize voices text with an ElevenLabs voice ID
+func (e *ElevenLabs) Synthesize(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error) {
+	format = outputFormat(format)
+	overrides := e.voices.EmotionSettings(voice, e.client.Model(), string(emotion))
+	audio, err := e.client.TextToSpeechWithSettings(ctx, voice, text, elevenlabs.EmotionType(emotion), overrides, string(format))
+	if err != nil || audio == nil {
+		return nil, err
+	}
+	return &Speech{Audio: audio, Format: format}, nil
+}
+
+// SynthesizeTimed voices text and returns the word timings from ElevenLabs' alignment
+func (e *ElevenLabs) SynthesizeTimed(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error) {
+	format = outputFormat(format)
+	overrides := e.voices.EmotionSettings(voice, e.client.Model(), string(emotion))
+	timed, err := e.client.TextToSpeechWithTimestamps(ctx, voice, text, elevenlabs.EmotionType(emotion), overrides, string(format))
+	if err != nil || timed == nil {
+		return nil, err
+	}
+
+	alignment := timed.Alignment
+	return &Speech{
+		Audio:  timed.Audio,
+		Format: format,
+		Words:  alignedWords(alignment.Characters, alignment.StartTimes, alignment.EndTimes),
+	}, nil
+}
+
+// SynthesizeStream streams text voiced with an ElevenLabs voice ID
+func (e *ElevenLabs) SynthesizeStream(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Stream, error) {
+	format = outputFormat(format)
+	overrides := e.voices.EmotionSettings(voice, e.client.Model(), string(emotion))
+	audio, err := e.client.TextToSpeechStreamWithSettings(ctx, voice, text, elevenlabs.EmotionType(emotion), overrides, string(format))
+	if err != nil || audio == nil {
+		return nil, err
+	}
+	return &Stream{ReadCloser: audio, Format: format}, nil
+}
+
+// outputFormat returns the ElevenLabs output format closest to format
+func outputFormat(format Format) Format {
+	if format == "" || format == FormatWAV {
+		return DefaultFormat
+	}
+	return format
+}
+
+// alignedWords groups per-character timings into words
+func alignedWords(characters []string, starts, ends []float64) []models.SubtitleWord {
+	if len(starts) < len(characters) || len(ends) < len(characters) {
+		return nil
+	}
+
+	var words []models.SubtitleWord
+	var current *models.SubtitleWord
+	for i, char := range characters {
+		if strings.TrimSpace(char) == "" {
+			current = nil
+			continue
+		}
+
+		end := time.Duration(ends[i] * float64(time.Second))
+		if current == nil {
+			words = append(words, models.SubtitleWord{
+				Offset: i,
+				Start:  time.Duration(starts[i] * float64(time.Second)),
+			})
+			current = &words[len(words)-1]
+		}
+		current.Text += char
+		current.End = end
+	}
+	return words
+}
@@ -0,0 +1,57 @@
+package tts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is an audio encoding, named like ElevenLabs output formats
+type Format string
+
+const (
+	FormatMP3Low   Format = "mp3_44100_64"
+	FormatMP3      Format = "mp3_44100_128"
+	FormatMP3High  Format = "mp3_44100_192"
+	FormatOpus     Format = "opus_48000_64"
+	FormatPCM16000 Format = "pcm_16000"
+	FormatPCM24000 Format = "pcm_24000"
+	FormatWAV      Format = "wav" // Only produced locally
+)
+
+// DefaultFormat is used when the client has no preference
+const DefaultFormat = FormatMP3
+
+// MimeType returns the MIME type of audio in the format. Raw PCM is 16-bit
+// little-endian mono.
+func (f Format) MimeType() string {
+	switch {
+	case f == "":
+		return DefaultFormat.MimeType()
+	case strings.HasPrefix(string(f), "mp3_"):
+		return "audio/mpeg"
+	case strings.HasPrefix(string(f), "opus_"):
+		return "audio/ogg; codecs=opus"
+	case f.IsPCM():
+		return fmt.Sprintf("audio/pcm; rate=%d; channels=1", f.SampleRate())
+	case f == FormatWAV:
+		return "audio/wav"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// IsPCM reports whether the format is raw PCM without a container
+func (f Format) IsPCM() bool {
+	return strings.HasPrefix(string(f), "pcm_")
+}
+
+// SampleRate returns the sample rate of a PCM format, or 0
+func (f Format) SampleRate() int {
+	if !f.IsPCM() {
+		return 0
+	}
+
+	var rate int
+	fmt.Sscanf(strings.TrimPrefix(string(f), "pcm_"), "%d", &rate)
+	return rate
+}
@@ -0,0 +1,101 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Local synthesizes speech without network access. With a command it runs
+// an offline TTS engine such as piper or espeak-ng; without one it returns
+// procedurally generated placeholder audio.
+//
+// The command gets the text on stdin and must write WAV audio to stdout. The
+// placeholders {voice} and {emotion} in its arguments are replaced, and the
+// same values are passed in the TTS_VOICE and TTS_EMOTION environment
+// variables.
+type Local struct {
+	command []string
+}
+
+// NewLocal creates a local synthesizer; an empty command uses placeholder audio
+func NewLocal(command string) *Local {
+	return &Local{command: strings.Fields(command)}
+}
+
+// Synthesize voices text like SynthesizeTimed, without word timings
+func (l *Local) Synthesize(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error) {
+	speech, err := l.SynthesizeTimed(ctx, voice, text, emotion, format)
+	if err != nil {
+		return nil, err
+	}
+	speech.Words = nil
+	return speech, nil
+}
+
+// SynthesizeTimed voices text with the local command or placeholder audio.
+// Only placeholder audio has word timings; a local command's output has none.
+func (l *Local) SynthesizeTimed(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error) {
+	if len(l.command) == 0 {
+		return Placeholder(voice, text, emotion, format), nil
+	}
+
+	cmd := l.cmd(ctx, voice, text, emotion)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("tts command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return &Speech{Audio: stdout.Bytes(), Format: FormatWAV}, nil
+}
+
+// SynthesizeStream streams the local command's output or placeholder audio
+func (l *Local) SynthesizeStream(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Stream, error) {
+	if len(l.command) == 0 {
+		speech := Placeholder(voice, text, emotion, format)
+		return &Stream{ReadCloser: io.NopCloser(bytes.NewReader(speech.Audio)), Format: speech.Format}, nil
+	}
+
+	cmd := l.cmd(ctx, voice, text, emotion)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tts command output: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start tts command: %w", err)
+	}
+
+	return &Stream{ReadCloser: &commandReader{ReadCloser: stdout, cmd: cmd}, Format: FormatWAV}, nil
+}
+
+// cmd builds the command for one line
+func (l *Local) cmd(ctx context.Context, voice, text string, emotion Emotion) *exec.Cmd {
+	replacer := strings.NewReplacer("{voice}", voice, "{emotion}", string(emotion))
+	args := make([]string, len(l.command)-1)
+	for i, arg := range l.command[1:] {
+		args[i] = replacer.Replace(arg)
+	}
+
+	cmd := exec.CommandContext(ctx, l.command[0], args...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Env = append(os.Environ(), "TTS_VOICE="+voice, "TTS_EMOTION="+string(emotion))
+	return cmd
+}
+
+// commandReader reads a command's output and waits for it on Close
+type commandReader struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+}
+
+func (r *commandReader) Close() error {
+	r.ReadCloser.Close()
+	return r.cmd.Wait()
+}
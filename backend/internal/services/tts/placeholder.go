@@ -0,0 +1,156 @@
+package tts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"time"
+	"unicode"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+const (
+	// Tones need little fidelity, and clips are stored with the session, so
+	// WAV placeholders are 8 kHz at 8 bits: about 8 KB per second
+	placeholderSampleRate = 8000
+	placeholderMaxSeconds = 20
+)
+
+// Placeholder returns a clip of babble tones, one per word, with the time
+// each tone plays, so audio and subtitle paths can be exercised without a
+// real TTS engine. The same voice always gets the same pitch, and the
+// emotion changes pitch, pace and volume. PCM formats get raw samples at
+// their rate; every other format gets a small 8 kHz, 8-bit WAV.
+func Placeholder(voice, text string, emotion Emotion, format Format) *Speech {
+	rate := placeholderSampleRate
+	if format.IsPCM() && format.SampleRate() > 0 {
+		rate = format.SampleRate()
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(voice))
+	pitch := 110 + float64(h.Sum32()%150) // Hz
+
+	pace, volume := 1.0, 0.3
+	switch emotion {
+	case EmotionHappy:
+		pitch *= 1.2
+	case EmotionAngry:
+		pace, volume = 1.2, 0.5
+	case EmotionFurious:
+		pitch *= 1.1
+		pace, volume = 1.4, 0.6
+	case EmotionSad:
+		pitch *= 0.85
+		pace, volume = 0.8, 0.2
+	case EmotionNervous:
+		pitch *= 1.15
+		pace = 1.3
+	case EmotionSarcastic:
+		pitch *= 1.05
+		pace = 0.9
+	case EmotionPleading:
+		pitch *= 1.15
+		pace, volume = 0.9, 0.25
+	case EmotionSuspicious:
+		pitch *= 0.95
+		pace = 0.85
+	case EmotionRelieved:
+		pitch *= 1.05
+		pace, volume = 0.9, 0.25
+	}
+
+	maxSamples := placeholderMaxSeconds * rate
+	var samples []int16
+	var words []models.SubtitleWord
+	for i, word := range placeholderWords(text) {
+		toneSamples := int(float64(rate) * (0.05 + 0.012*float64(len(word.Text))) / pace)
+		gapSamples := int(float64(rate) * 0.03 / pace)
+		if len(samples)+toneSamples+gapSamples > maxSamples {
+			break
+		}
+
+		// Vary the pitch a little from word to word like speech
+		freq := pitch * (1 + 0.08*math.Sin(float64(i)*1.7))
+		if emotion == EmotionNervous {
+			freq *= 1 + 0.05*math.Sin(float64(i)*5.3)
+		}
+
+		word.Start = sampleTime(len(samples), rate)
+		word.End = sampleTime(len(samples)+toneSamples, rate)
+		words = append(words, word)
+
+		for n := 0; n < toneSamples; n++ {
+			// Fade each tone in and out to avoid clicks
+			envelope := math.Sin(math.Pi * float64(n) / float64(toneSamples))
+			value := volume * envelope * math.Sin(2*math.Pi*freq*float64(n)/float64(rate))
+			samples = append(samples, int16(value*math.MaxInt16))
+		}
+		samples = append(samples, make([]int16, gapSamples)...)
+	}
+
+	if format.IsPCM() {
+		return &Speech{Audio: encodePCM(samples), Format: format, Words: words}
+	}
+	return &Speech{Audio: encodeWAV(samples, rate), Format: FormatWAV, Words: words}
+}
+
+// placeholderWords splits text on whitespace, keeping each word's character offset
+func placeholderWords(text string) []models.SubtitleWord {
+	var words []models.SubtitleWord
+	var current *models.SubtitleWord
+	for i, r := range []rune(text) {
+		if unicode.IsSpace(r) {
+			current = nil
+			continue
+		}
+		if current == nil {
+			words = append(words, models.SubtitleWord{Offset: i})
+			current = &words[len(words)-1]
+		}
+		current.Text += string(r)
+	}
+	return words
+}
+
+// sampleTime converts a sample count to a duration
+func sampleTime(samples, rate int) time.Duration {
+	return time.Duration(samples) * time.Second / time.Duration(rate)
+}
+
+// encodePCM returns samples as raw 16-bit little-endian PCM
+func encodePCM(samples []int16) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, samples)
+	return buf.Bytes()
+}
+
+// encodeWAV wraps samples in a WAV container as 8-bit mono PCM
+func encodeWAV(samples []int16, sampleRate int) []byte {
+	dataSize := len(samples)
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(36+dataSize))
+	buf.WriteString("WAVE")
+
+	buf.WriteString("fmt ")
+	binary.Write(&buf, binary.LittleEndian, uint32(16))         // Chunk size
+	binary.Write(&buf, binary.LittleEndian, uint16(1))          // PCM
+	binary.Write(&buf, binary.LittleEndian, uint16(1))          // Mono
+	binary.Write(&buf, binary.LittleEndian, uint32(sampleRate)) // Sample rate
+	binary.Write(&buf, binary.LittleEndian, uint32(sampleRate)) // Byte rate
+	binary.Write(&buf, binary.LittleEndian, uint16(1))          // Block align
+	binary.Write(&buf, binary.LittleEndian, uint16(8))          // Bits per sample
+
+	// 8-bit WAV samples are unsigned, centred on 128
+	buf.WriteString("data")
+	binary.Write(&buf, binary.LittleEndian, uint32(dataSize))
+	for _, sample := range samples {
+		buf.WriteByte(byte(int(sample)>>8 + 128))
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,75 @@
+package tts
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/lexicon"
+	"github.com/ttrubel/send-me-home/internal/resilience"
+	"github.com/ttrubel/send-me-home/internal/services/elevenlabs"
+	"github.com/ttrubel/send-me-home/internal/voices"
+)
+
+// NewSynthesizer returns the synthesizer for the configured TTS provider
+func NewSynthesizer(cfg *config.Config, voices *voices.Registry, lex *lexicon.Lexicon) (SpeechSynthesizer, error) {
+	switch cfg.TTSProvider {
+	case "", "elevenlabs":
+		client := elevenlabs.NewClient(cfg.ElevenLabsAPIKey, cfg.ElevenLabsModel).WithLexicon(lex)
+		return guard(cfg, "elevenlabs", NewElevenLabs(client, voices)), nil
+	case "local":
+		return NewLocal(cfg.TTSCommand), nil
+	default:
+		return nil, fmt.Errorf("unknown TTS provider: %s", cfg.TTSProvider)
+	}
+}
+
+// guard retries a provider's failed syntheses and stops calling it while
+// its circuit is open
+func guard(cfg *config.Config, provider string, s SpeechSynthesizer) SpeechSynthesizer {
+	policy := resilience.ConfiguredPolicy(cfg, cfg.SpeechCallTimeout)
+	return &guardedSynthesizer{
+		synth: s,
+		guard: resilience.NewGuard(provider, policy, resilience.ConfiguredBreaker(cfg, provider)),
+	}
+}
+
+type guardedSynthesizer struct {
+	synth SpeechSynthesizer
+	guard *resilience.Guard
+}
+
+func (s *guardedSynthesizer) Synthesize(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error) {
+	return resilience.Call(ctx, s.guard, func(ctx context.Context) (*Speech, error) {
+		return s.synth.Synthesize(ctx, voice, text, emotion, format)
+	})
+}
+
+func (s *guardedSynthesizer) SynthesizeTimed(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error) {
+	return resilience.Call(ctx, s.guard, func(ctx context.Context) (*Speech, error) {
+		return s.synth.SynthesizeTimed(ctx, voice, text, emotion, format)
+	})
+}
+
+func (s *guardedSynthesizer) SynthesizeStream(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Stream, error) {
+	stream, cancel, err := resilience.CallStream(ctx, s.guard, func(ctx context.Context) (*Stream, error) {
+		return s.synth.SynthesizeStream(ctx, voice, text, emotion, format)
+	})
+	if err != nil || stream == nil {
+		cancel()
+		return nil, err
+	}
+	return &Stream{ReadCloser: &cancelOnClose{ReadCloser: stream.ReadCloser, cancel: cancel}, Format: stream.Format}, nil
+}
+
+// cancelOnClose ends a stream's context when the stream is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
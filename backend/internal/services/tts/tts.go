@@ -0,0 +1,72 @@
+// Package tts turns NPC lines into speech
+package tts
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// Emotion is the emotional delivery of a line
+type Emotion string
+
+const (
+	EmotionNeutral    Emotion = "neutral"
+	EmotionHappy      Emotion = "happy"
+	EmotionAngry      Emotion = "angry"
+	EmotionFurious    Emotion = "furious"
+	EmotionSad        Emotion = "sad"
+	EmotionNervous    Emotion = "nervous"
+	EmotionSarcastic  Emotion = "sarcastic"
+	EmotionPleading   Emotion = "pleading"
+	EmotionSuspicious Emotion = "suspicious"
+	EmotionRelieved   Emotion = "relieved"
+)
+
+// Emotions lists every emotion a line can be voiced with
+var Emotions = []Emotion{
+	EmotionNeutral, EmotionHappy, EmotionAngry, EmotionFurious, EmotionSad,
+	EmotionNervous, EmotionSarcastic, EmotionPleading, EmotionSuspicious, EmotionRelieved,
+}
+
+// ParseEmotion returns the emotion named by s, or EmotionNeutral if it is unknown
+func ParseEmotion(s string) Emotion {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, e := range Emotions {
+		if string(e) == s {
+			return e
+		}
+	}
+	return EmotionNeutral
+}
+
+// SpeechSynthesizer voices NPC lines. The format is a preference: a
+// synthesizer that can't produce it returns another and says so in the
+// result. An empty format means DefaultFormat.
+type SpeechSynthesizer interface {
+	// Synthesize returns the audio for text, or nil if speech is disabled
+	Synthesize(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error)
+
+	// SynthesizeTimed returns the audio for text with subtitle timings, or
+	// nil if speech is disabled. Words is empty when timings are unavailable.
+	SynthesizeTimed(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Speech, error)
+
+	// SynthesizeStream returns the audio as it is produced, or nil if speech
+	// is disabled. The caller must close the stream.
+	SynthesizeStream(ctx context.Context, voice, text string, emotion Emotion, format Format) (*Stream, error)
+}
+
+// Speech is synthesized audio with the time each word is spoken
+type Speech struct {
+	Audio  []byte
+	Format Format
+	Words  []models.SubtitleWord
+}
+
+// Stream is audio read as it is synthesized
+type Stream struct {
+	io.ReadCloser
+	Format Format
+}
@@ -0,0 +1,59 @@
+package shift
+
+import (
+	"fmt"
+	"time"
+)
+
+// In-game shift hours. The desk opens at StartHour and the last shuttle
+// departs at DepartureHour; the real-time length of a shift is configurable.
+const (
+	StartHour     = 6
+	DepartureHour = 18
+)
+
+// Clock is the server-side clock of a single shift
+type Clock struct {
+	StartedAt time.Time
+	Length    time.Duration // Real time between opening and shuttle departure
+}
+
+// NewClock returns a clock for a shift that started at startedAt
+func NewClock(startedAt time.Time, length time.Duration) Clock {
+	return Clock{StartedAt: startedAt, Length: length}
+}
+
+// Enabled reports whether the shift has time pressure at all
+func (c Clock) Enabled() bool {
+	return c.Length > 0 && !c.StartedAt.IsZero()
+}
+
+// Remaining returns the real time left before the shuttle departs
+func (c Clock) Remaining(now time.Time) time.Duration {
+	if !c.Enabled() {
+		return 0
+	}
+	remaining := c.StartedAt.Add(c.Length).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// Departed reports whether the shuttle has left
+func (c Clock) Departed(now time.Time) bool {
+	return c.Enabled() && c.Remaining(now) == 0
+}
+
+// GameTime returns the in-game wall clock, e.g. "14:35"
+func (c Clock) GameTime(now time.Time) string {
+	if !c.Enabled() {
+		return ""
+	}
+
+	progress := float64(c.Length-c.Remaining(now)) / float64(c.Length)
+	minutes := int(progress * float64((DepartureHour-StartHour)*60))
+	minutes += StartHour * 60
+
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
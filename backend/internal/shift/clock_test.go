@@ -0,0 +1,59 @@
+package shift
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		clock         Clock
+		elapsed       time.Duration
+		wantRemaining time.Duration
+		wantDeparted  bool
+		wantGameTime  string
+	}{
+		{"opening", NewClock(start, 10*time.Minute), 0, 10 * time.Minute, false, "06:00"},
+		{"midway", NewClock(start, 10*time.Minute), 5 * time.Minute, 5 * time.Minute, false, "12:00"},
+		{"last second", NewClock(start, 10*time.Minute), 10*time.Minute - time.Second, time.Second, false, "17:58"},
+		{"departure", NewClock(start, 10*time.Minute), 10 * time.Minute, 0, true, "18:00"},
+		{"long after", NewClock(start, 10*time.Minute), time.Hour, 0, true, "18:00"},
+		{"disabled", NewClock(start, 0), time.Hour, 0, false, ""},
+		{"not started", NewClock(time.Time{}, 10*time.Minute), time.Hour, 0, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start.Add(tt.elapsed)
+			if got := tt.clock.Remaining(now); got != tt.wantRemaining {
+				t.Errorf("Remaining = %s, want %s", got, tt.wantRemaining)
+			}
+			if got := tt.clock.Departed(now); got != tt.wantDeparted {
+				t.Errorf("Departed = %v, want %v", got, tt.wantDeparted)
+			}
+			if got := tt.clock.GameTime(now); got != tt.wantGameTime {
+				t.Errorf("GameTime = %q, want %q", got, tt.wantGameTime)
+			}
+		})
+	}
+}
+
+func TestClockEnabled(t *testing.T) {
+	tests := []struct {
+		name  string
+		clock Clock
+		want  bool
+	}{
+		{"running", NewClock(time.Now(), time.Minute), true},
+		{"no length", NewClock(time.Now(), 0), false},
+		{"first case not served", NewClock(time.Time{}, time.Minute), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.clock.Enabled(); got != tt.want {
+				t.Errorf("Enabled = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Package usage measures what the game spends on AI providers. Clients
+// record each call with Record; the counts add up to server-wide totals and,
+// when the call's context is scoped to a session, to that session and its
+// player in Firestore.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/config"
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// maxFlushAttempts is how many times a session's usage is retried before
+// it is dropped, e.g. when the generation job creating the session failed
+const maxFlushAttempts = 10
+
+var (
+	totalsMu  sync.Mutex
+	totals    models.Usage
+	observers []func(ctx context.Context, u models.Usage)
+)
+
+// Record adds the usage of one provider call to the server totals and to
+// the session the context is scoped to, if any
+func Record(ctx context.Context, u models.Usage) {
+	totalsMu.Lock()
+	totals.Add(u)
+	notify := observers
+	totalsMu.Unlock()
+
+	for _, observe := range notify {
+		observe(ctx, u)
+	}
+
+	if s, ok := ctx.Value(scopeKey{}).(scope); ok {
+		s.ledger.add(s.sessionID, u)
+	}
+}
+
+// Observe calls fn with every usage recorded from now on
+func Observe(fn func(ctx context.Context, u models.Usage)) {
+	totalsMu.Lock()
+	defer totalsMu.Unlock()
+	observers = append(observers, fn)
+}
+
+// Totals returns the server's usage since it started
+func Totals() models.Usage {
+	totalsMu.Lock()
+	defer totalsMu.Unlock()
+	return totals
+}
+
+// Store persists usage per session and player
+type Store interface {
+	// AddUsage adds to a session's usage and to its player's
+	AddUsage(ctx context.Context, sessionID string, u models.Usage) error
+}
+
+// Ledger collects session usage in memory and writes it to a Store in batches
+type Ledger struct {
+	store Store
+
+	mu      sync.Mutex
+	pending map[string]*pendingUsage
+}
+
+type pendingUsage struct {
+	usage    models.Usage
+	attempts int
+}
+
+// NewLedger creates a ledger that writes to store
+func NewLedger(store Store) *Ledger {
+	return &Ledger{store: store, pending: make(map[string]*pendingUsage)}
+}
+
+type scopeKey struct{}
+
+type scope struct {
+	ledger    *Ledger
+	sessionID string
+}
+
+// WithSession returns a context whose provider calls count toward a session
+func (l *Ledger) WithSession(ctx context.Context, sessionID string) context.Context {
+	if l == nil || sessionID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, scopeKey{}, scope{ledger: l, sessionID: sessionID})
+}
+
+// SessionID returns the session ctx is scoped to, or ""
+func SessionID(ctx context.Context) string {
+	s, _ := ctx.Value(scopeKey{}).(scope)
+	return s.sessionID
+}
+
+func (l *Ledger) add(sessionID string, u models.Usage) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	p, ok := l.pending[sessionID]
+	if !ok {
+		p = &pendingUsage{}
+		l.pending[sessionID] = p
+	}
+	p.usage.Add(u)
+}
+
+// Flush writes the pending usage. Sessions that fail are retried on the
+// next flush.
+func (l *Ledger) Flush(ctx context.Context) {
+	l.mu.Lock()
+	batch := l.pending
+	l.pending = make(map[string]*pendingUsage)
+	l.mu.Unlock()
+
+	for sessionID, p := range batch {
+		if p.usage.IsZero() {
+			continue
+		}
+
+		err := l.store.AddUsage(ctx, sessionID, p.usage)
+		if err == nil {
+			continue
+		}
+
+		p.attempts++
+		if p.attempts >= maxFlushAttempts {
+			log.Printf("Warning: Dropping usage of session %s after %d attempts: %v", sessionID, p.attempts, err)
+			continue
+		}
+
+		// Merge back with anything recorded meanwhile
+		l.mu.Lock()
+		if current, ok := l.pending[sessionID]; ok {
+			p.usage.Add(current.usage)
+		}
+		l.pending[sessionID] = p
+		l.mu.Unlock()
+	}
+}
+
+// Run flushes the ledger every interval until ctx is done, then flushes
+// once more
+func (l *Ledger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			l.Flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			l.Flush(ctx)
+		}
+	}
+}
+
+// Prices converts usage to an estimated cost in US dollars
+type Prices struct {
+	PromptPerMillion      float64 // Per million prompt tokens
+	OutputPerMillion      float64 // Per million output tokens
+	CharactersPerThousand float64 // Per thousand TTS characters
+}
+
+// ConfiguredPrices returns the prices set in the configuration
+func ConfiguredPrices(cfg *config.Config) Prices {
+	return Prices{
+		PromptPerMillion:      cfg.PromptTokenPrice,
+		OutputPerMillion:      cfg.OutputTokenPrice,
+		CharactersPerThousand: cfg.TTSCharacterPrice,
+	}
+}
+
+// Cost estimates what the usage cost
+func (p Prices) Cost(u models.Usage) float64 {
+	return float64(u.PromptTokens)/1e6*p.PromptPerMillion +
+		float64(u.OutputTokens)/1e6*p.OutputPerMillion +
+		float64(u.TTSCharacters)/1e3*p.CharactersPerThousand
+}
+
+// MetricsHandler serves the server totals in the Prometheus text format
+func MetricsHandler(prices Prices) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u := Totals()
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+		counters := []struct {
+			name, help string
+			value      int64
+		}{
+			{"llm_calls_total", "LLM completions requested.", u.LLMCalls},
+			{"llm_prompt_tokens_total", "LLM prompt tokens billed.", u.PromptTokens},
+			{"llm_output_tokens_total", "LLM output tokens billed, including thinking.", u.OutputTokens},
+			{"tts_calls_total", "Text-to-speech requests.", u.TTSCalls},
+			{"tts_characters_total", "Text-to-speech characters billed.", u.TTSCharacters},
+		}
+		for _, c := range counters {
+			fmt.Fprintf(w, "# HELP send_me_home_%s %s\n# TYPE send_me_home_%s counter\nsend_me_home_%s %d\n", c.name, c.help, c.name, c.name, c.value)
+		}
+		fmt.Fprintf(w, "# HELP send_me_home_estimated_cost_usd_total Estimated provider spend.\n# TYPE send_me_home_estimated_cost_usd_total counter\nsend_me_home_estimated_cost_usd_total %g\n", prices.Cost(u))
+	})
+}
@@ -0,0 +1,219 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// fakeStore keeps the usage written to it in memory
+type fakeStore struct {
+	mu     sync.Mutex
+	usage  map[string]models.Usage
+	writes int
+	err    error // Returned by every write while set
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{usage: make(map[string]models.Usage)}
+}
+
+func (s *fakeStore) AddUsage(ctx context.Context, sessionID string, u models.Usage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes++
+	if s.err != nil {
+		return s.err
+	}
+	total := s.usage[sessionID]
+	total.Add(u)
+	s.usage[sessionID] = total
+	return nil
+}
+
+func (s *fakeStore) get(sessionID string) models.Usage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.usage[sessionID]
+}
+
+func (s *fakeStore) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func TestWithSession(t *testing.T) {
+	l := NewLedger(nil)
+	tests := []struct {
+		name   string
+		ledger *Ledger
+		id     string
+		want   string
+	}{
+		{"scoped", l, "s1", "s1"},
+		{"no session", l, "", ""},
+		{"no ledger", nil, "s1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ledger.WithSession(context.Background(), tt.id)
+			if got := SessionID(ctx); got != tt.want {
+				t.Errorf("SessionID = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// The innermost scope wins
+	ctx := l.WithSession(l.WithSession(context.Background(), "outer"), "inner")
+	if got := SessionID(ctx); got != "inner" {
+		t.Errorf("nested SessionID = %q, want inner", got)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	store := newFakeStore()
+	l := NewLedger(store)
+
+	var mu sync.Mutex
+	var observed []string
+	Observe(func(ctx context.Context, u models.Usage) {
+		mu.Lock()
+		defer mu.Unlock()
+		observed = append(observed, SessionID(ctx))
+	})
+
+	before := Totals()
+	Record(l.WithSession(context.Background(), "s1"), models.Usage{LLMCalls: 1, PromptTokens: 100, OutputTokens: 20})
+	Record(l.WithSession(context.Background(), "s1"), models.Usage{TTSCalls: 1, TTSCharacters: 40})
+	Record(l.WithSession(context.Background(), "s2"), models.Usage{LLMCalls: 1, PromptTokens: 5})
+	Record(context.Background(), models.Usage{LLMCalls: 1, PromptTokens: 1000})
+
+	// Server totals count every call, in or out of a session
+	after := Totals()
+	if got := after.PromptTokens - before.PromptTokens; got != 1105 {
+		t.Errorf("total prompt tokens grew by %d, want 1105", got)
+	}
+	if got := after.LLMCalls - before.LLMCalls; got != 3 {
+		t.Errorf("total LLM calls grew by %d, want 3", got)
+	}
+
+	mu.Lock()
+	if len(observed) < 4 || observed[len(observed)-1] != "" || observed[len(observed)-2] != "s2" {
+		t.Errorf("observers saw sessions %q", observed)
+	}
+	mu.Unlock()
+
+	l.Flush(context.Background())
+	if got, want := store.get("s1"), (models.Usage{LLMCalls: 1, PromptTokens: 100, OutputTokens: 20, TTSCalls: 1, TTSCharacters: 40}); got != want {
+		t.Errorf("s1 usage = %+v, want %+v", got, want)
+	}
+	if got, want := store.get("s2"), (models.Usage{LLMCalls: 1, PromptTokens: 5}); got != want {
+		t.Errorf("s2 usage = %+v, want %+v", got, want)
+	}
+	if len(store.usage) != 2 {
+		t.Errorf("usage written for %d sessions, want 2", len(store.usage))
+	}
+}
+
+func TestFlush(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int   // Flushes failing before the store recovers
+		want     int64 // Prompt tokens stored in the end
+	}{
+		{"written at once", 0, 30},
+		{"retried", 3, 30},
+		{"dropped after the last attempt", maxFlushAttempts, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeStore()
+			l := NewLedger(store)
+			ctx := l.WithSession(context.Background(), "s1")
+
+			Record(ctx, models.Usage{PromptTokens: 10})
+			store.fail(errors.New("unavailable"))
+			for i := range tt.failures {
+				l.Flush(context.Background())
+				// Usage recorded between attempts joins the retried batch
+				if i == 0 {
+					Record(ctx, models.Usage{PromptTokens: 20})
+				}
+			}
+			store.fail(nil)
+
+			if tt.failures == 0 {
+				Record(ctx, models.Usage{PromptTokens: 20})
+			}
+			l.Flush(context.Background())
+
+			if got := store.get("s1").PromptTokens; got != tt.want {
+				t.Errorf("stored prompt tokens = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlushSkipsEmptyUsage(t *testing.T) {
+	store := newFakeStore()
+	l := NewLedger(store)
+	Record(l.WithSession(context.Background(), "s1"), models.Usage{})
+	l.Flush(context.Background())
+	if store.writes != 0 {
+		t.Errorf("%d writes for empty usage, want 0", store.writes)
+	}
+}
+
+func TestRun(t *testing.T) {
+	store := newFakeStore()
+	l := NewLedger(store)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		l.Run(ctx, time.Hour)
+		close(done)
+	}()
+
+	Record(l.WithSession(context.Background(), "s1"), models.Usage{PromptTokens: 7})
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return after its context was cancelled")
+	}
+
+	// The final flush runs on its own context, past the cancelled one
+	if got := store.get("s1").PromptTokens; got != 7 {
+		t.Errorf("stored prompt tokens = %d, want 7 from the final flush", got)
+	}
+}
+
+func TestCost(t *testing.T) {
+	p := Prices{PromptPerMillion: 0.5, OutputPerMillion: 2, CharactersPerThousand: 0.3}
+	tests := []struct {
+		name  string
+		usage models.Usage
+		want  float64
+	}{
+		{"nothing", models.Usage{}, 0},
+		{"prompt", models.Usage{PromptTokens: 2_000_000}, 1},
+		{"output", models.Usage{OutputTokens: 500_000}, 1},
+		{"speech", models.Usage{TTSCharacters: 10_000}, 3},
+		{"calls are free", models.Usage{LLMCalls: 10, TTSCalls: 10}, 0},
+		{"everything", models.Usage{PromptTokens: 1_000_000, OutputTokens: 1_000_000, TTSCharacters: 1000}, 2.8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Cost(tt.usage); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Cost = %g, want %g", got, tt.want)
+			}
+		})
+	}
+}
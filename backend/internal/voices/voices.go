@@ -0,0 +1,356 @@
+// Package voices casts NPCs to the available TTS voices
+package voices
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+// defaultCatalog is the ElevenLabs premade voice catalog, used when no
+// voices file is configured
+//
+//go:embed default_voices.json
+var defaultCatalog []byte
+
+// Voice is a TTS voice described with the same attributes as a casting
+type Voice struct {
+	ID          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Enabled     bool                      `json:"enabled"`
+	VocalAge    string                    `json:"vocal_age"`
+	Pitch       string                    `json:"pitch"`
+	Timbres     []string                  `json:"timbres"`
+	Accent      string                    `json:"accent"`
+	Energy      string                    `json:"energy"`
+	Languages   []string                  `json:"languages,omitempty"` // e.g. "en", "es"
+	Emotions    EmotionProfile            `json:"emotions,omitempty"`  // Overrides for this voice
+	Models      map[string]EmotionProfile `json:"models,omitempty"`    // Overrides for this voice with a TTS model
+}
+
+// EmotionProfile tunes voice settings per emotion
+type EmotionProfile map[string]EmotionSettings
+
+// EmotionSettings overrides voice settings for one emotion. Unset fields
+// keep the provider's defaults for that emotion.
+type EmotionSettings struct {
+	Stability       *float64 `json:"stability,omitempty"`
+	SimilarityBoost *float64 `json:"similarity_boost,omitempty"`
+	Style           *float64 `json:"style,omitempty"`
+	UseSpeakerBoost *bool    `json:"use_speaker_boost,omitempty"`
+}
+
+// Map returns the overrides as ElevenLabs voice settings
+func (s EmotionSettings) Map() map[string]interface{} {
+	settings := make(map[string]interface{})
+	if s.Stability != nil {
+		settings["stability"] = *s.Stability
+	}
+	if s.SimilarityBoost != nil {
+		settings["similarity_boost"] = *s.SimilarityBoost
+	}
+	if s.Style != nil {
+		settings["style"] = *s.Style
+	}
+	if s.UseSpeakerBoost != nil {
+		settings["use_speaker_boost"] = *s.UseSpeakerBoost
+	}
+	return settings
+}
+
+// catalog is the format of a voices file
+type catalog struct {
+	Emotions EmotionProfile            `json:"emotions,omitempty"` // Overrides for every voice
+	Models   map[string]EmotionProfile `json:"models,omitempty"`   // Overrides for every voice with a TTS model
+	Voices   []Voice                   `json:"voices"`
+}
+
+// Ordered scales; neighbouring values are a partial match
+var (
+	vocalAges = []string{"young", "adult", "middle_aged", "elderly"}
+	levels    = []string{"low", "medium", "high"}
+)
+
+// Attribute weights. Pitch and age matter most for whether a voice fits.
+const (
+	pitchWeight    = 3
+	vocalAgeWeight = 3
+	timbreWeight   = 2
+	accentWeight   = 2
+	energyWeight   = 1
+)
+
+// Registry holds the voices NPCs can be cast to. It is safe for concurrent
+// use and can be reloaded while the server runs.
+type Registry struct {
+	path string // Empty for the built-in catalog
+
+	mu      sync.RWMutex
+	catalog catalog
+	modTime time.Time
+}
+
+// Load creates a registry from a voices file, or from the built-in catalog
+// when path is empty
+func Load(path string) (*Registry, error) {
+	r := &Registry{path: path}
+	if path == "" {
+		c, err := parseCatalog(defaultCatalog)
+		if err != nil {
+			return nil, fmt.Errorf("invalid built-in voice catalog: %w", err)
+		}
+		r.catalog = c
+		return r, nil
+	}
+
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload rereads the voices file if it changed and reports whether the
+// catalog was replaced. An invalid file leaves the current catalog in place.
+func (r *Registry) Reload() (bool, error) {
+	if r.path == "" {
+		return false, nil
+	}
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat voices file: %w", err)
+	}
+
+	r.mu.RLock()
+	unchanged := info.ModTime().Equal(r.modTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read voices file: %w", err)
+	}
+	c, err := parseCatalog(data)
+	if err != nil {
+		return false, fmt.Errorf("invalid voices file %s: %w", r.path, err)
+	}
+
+	r.mu.Lock()
+	r.catalog = c
+	r.modTime = info.ModTime()
+	r.mu.Unlock()
+
+	return true, nil
+}
+
+// Watch reloads the voices file every interval until ctx is done
+func (r *Registry) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				log.Printf("Warning: Failed to reload voices: %v", err)
+			} else if reloaded {
+				log.Printf("Reloaded %d voices from %s", len(r.Voices()), r.path)
+			}
+		}
+	}
+}
+
+// parseCatalog decodes and validates a voice catalog
+func parseCatalog(data []byte) (catalog, error) {
+	var c catalog
+	if err := json.Unmarshal(data, &c); err != nil {
+		return catalog{}, err
+	}
+
+	seen := make(map[string]bool, len(c.Voices))
+	enabled := 0
+	for _, v := range c.Voices {
+		if v.ID == "" {
+			return catalog{}, fmt.Errorf("voice %q has no id", v.Name)
+		}
+		if seen[v.ID] {
+			return catalog{}, fmt.Errorf("duplicate voice id %s", v.ID)
+		}
+		seen[v.ID] = true
+		if v.Enabled {
+			enabled++
+		}
+	}
+	if enabled == 0 {
+		return catalog{}, fmt.Errorf("no enabled voices")
+	}
+
+	return c, nil
+}
+
+// Voices returns every voice in the registry, including disabled ones
+func (r *Registry) Voices() []Voice {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.catalog.Voices
+}
+
+// enabled returns the voices NPCs can be cast to
+func (r *Registry) enabled() []Voice {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var voices []Voice
+	for _, v := range r.catalog.Voices {
+		if v.Enabled {
+			voices = append(voices, v)
+		}
+	}
+	return voices
+}
+
+// EmotionSettings returns the voice setting overrides for an emotion with
+// a TTS model, or nil if there are none. Catalog-wide profiles apply first,
+// then model profiles, then the voice's own, then the voice's model profile.
+func (r *Registry) EmotionSettings(voiceID, model, emotion string) map[string]interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	profiles := []EmotionProfile{r.catalog.Emotions, r.catalog.Models[model]}
+	for _, v := range r.catalog.Voices {
+		if v.ID == voiceID {
+			profiles = append(profiles, v.Emotions, v.Models[model])
+			break
+		}
+	}
+
+	var settings map[string]interface{}
+	for _, profile := range profiles {
+		override, ok := profile[emotion]
+		if !ok {
+			continue
+		}
+		if settings == nil {
+			settings = make(map[string]interface{})
+		}
+		for key, value := range override.Map() {
+			settings[key] = value
+		}
+	}
+	return settings
+}
+
+// Timbres returns every timbre the enabled voices offer
+func (r *Registry) Timbres() []string {
+	seen := make(map[string]bool)
+	var timbres []string
+	for _, v := range r.enabled() {
+		for _, t := range v.Timbres {
+			if !seen[t] {
+				seen[t] = true
+				timbres = append(timbres, t)
+			}
+		}
+	}
+	return timbres
+}
+
+// Cast returns the ID of the voice that best fits the casting. Ties are
+// broken by key, so the same character always gets the same voice.
+func (r *Registry) Cast(casting models.VoiceCasting, key string) string {
+	voices := r.enabled()
+	if len(voices) == 0 {
+		return ""
+	}
+
+	best := -1
+	var candidates []Voice
+	for _, v := range voices {
+		score := v.Score(casting)
+		switch {
+		case score > best:
+			best = score
+			candidates = []Voice{v}
+		case score == best:
+			candidates = append(candidates, v)
+		}
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return candidates[int(h.Sum32()%uint32(len(candidates)))].ID
+}
+
+// Score rates how well the voice fits a casting; higher is better.
+// Attributes the casting leaves empty don't count.
+func (v Voice) Score(casting models.VoiceCasting) int {
+	score := scaleScore(vocalAges, v.VocalAge, casting.VocalAge, vocalAgeWeight)
+	score += scaleScore(levels, v.Pitch, casting.Pitch, pitchWeight)
+	score += scaleScore(levels, v.Energy, casting.Energy, energyWeight)
+
+	for _, t := range v.Timbres {
+		if normalize(t) == normalize(casting.Timbre) {
+			score += 2 * timbreWeight
+			break
+		}
+	}
+
+	// A regional accent partly matches its broader region
+	voiceAccent, wantAccent := normalize(v.Accent), normalize(casting.Accent)
+	switch {
+	case wantAccent == "" || voiceAccent == "":
+	case voiceAccent == wantAccent:
+		score += 2 * accentWeight
+	case strings.HasPrefix(voiceAccent, wantAccent+"_") || strings.HasPrefix(wantAccent, voiceAccent+"_"):
+		score += accentWeight
+	}
+
+	return score
+}
+
+// scaleScore gives full weight for an exact match on an ordered scale and
+// partial weight for a neighbouring value
+func scaleScore(scale []string, have, want string, weight int) int {
+	i, j := indexOf(scale, have), indexOf(scale, want)
+	if i < 0 || j < 0 {
+		return 0
+	}
+
+	switch distance := max(i-j, j-i); distance {
+	case 0:
+		return 2 * weight
+	case 1:
+		return weight
+	default:
+		return 0
+	}
+}
+
+func indexOf(scale []string, value string) int {
+	value = normalize(value)
+	for i, s := range scale {
+		if s == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func normalize(s string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), " ", "_")
+}
@@ -0,0 +1,236 @@
+package voices
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ttrubel/send-me-home/internal/models"
+)
+
+func TestScore(t *testing.T) {
+	voice := Voice{
+		VocalAge: "adult",
+		Pitch:    "low",
+		Timbres:  []string{"deep", "smooth"},
+		Accent:   "british_northern",
+		Energy:   "medium",
+	}
+	tests := []struct {
+		name    string
+		casting models.VoiceCasting
+		want    int
+	}{
+		{"nothing asked", models.VoiceCasting{}, 0},
+		{"exact age", models.VoiceCasting{VocalAge: "adult"}, 2 * vocalAgeWeight},
+		{"neighbouring age", models.VoiceCasting{VocalAge: "middle_aged"}, vocalAgeWeight},
+		{"distant age", models.VoiceCasting{VocalAge: "elderly"}, 0},
+		{"exact pitch", models.VoiceCasting{Pitch: "low"}, 2 * pitchWeight},
+		{"distant pitch", models.VoiceCasting{Pitch: "high"}, 0},
+		{"neighbouring energy", models.VoiceCasting{Energy: "high"}, energyWeight},
+		{"timbre", models.VoiceCasting{Timbre: "Smooth"}, 2 * timbreWeight},
+		{"missing timbre", models.VoiceCasting{Timbre: "raspy"}, 0},
+		{"exact accent", models.VoiceCasting{Accent: "British Northern"}, 2 * accentWeight},
+		{"broader accent", models.VoiceCasting{Accent: "british"}, accentWeight},
+		{"other accent", models.VoiceCasting{Accent: "american"}, 0},
+		{"unknown scale value", models.VoiceCasting{VocalAge: "ancient", Pitch: "sub-bass"}, 0},
+		{"everything", models.VoiceCasting{VocalAge: "adult", Pitch: "low", Timbre: "deep", Accent: "british_northern", Energy: "medium"},
+			2 * (vocalAgeWeight + pitchWeight + timbreWeight + accentWeight + energyWeight)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := voice.Score(tt.casting); got != tt.want {
+				t.Errorf("Score = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// testRegistry returns a registry over voices without a file
+func testRegistry(voices ...Voice) *Registry {
+	return &Registry{catalog: catalog{Voices: voices}}
+}
+
+func TestCast(t *testing.T) {
+	r := testRegistry(
+		Voice{ID: "deep", Enabled: true, VocalAge: "elderly", Pitch: "low"},
+		Voice{ID: "bright", Enabled: true, VocalAge: "young", Pitch: "high"},
+		Voice{ID: "bright-twin", Enabled: true, VocalAge: "young", Pitch: "high"},
+		Voice{ID: "retired", Enabled: false, VocalAge: "elderly", Pitch: "low", Timbres: []string{"gravelly"}},
+	)
+
+	if got := r.Cast(models.VoiceCasting{VocalAge: "elderly", Pitch: "low", Timbre: "gravelly"}, "worker"); got != "deep" {
+		t.Errorf("Cast = %q, want the best enabled voice", got)
+	}
+
+	// Ties go to the same voice for the same key, and keys spread over them
+	young := models.VoiceCasting{VocalAge: "young", Pitch: "high"}
+	cast := make(map[string]bool)
+	for i := range 20 {
+		key := strings.Repeat("k", i+1)
+		first := r.Cast(young, key)
+		if first != "bright" && first != "bright-twin" {
+			t.Fatalf("Cast(%q) = %q, want one of the tied voices", key, first)
+		}
+		if again := r.Cast(young, key); again != first {
+			t.Fatalf("Cast(%q) = %q, then %q", key, first, again)
+		}
+		cast[first] = true
+	}
+	if len(cast) != 2 {
+		t.Errorf("tied voices cast: %v, want both", cast)
+	}
+
+	if got := testRegistry().Cast(young, "worker"); got != "" {
+		t.Errorf("Cast with no voices = %q, want empty", got)
+	}
+}
+
+func TestParseCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string // "" if valid
+	}{
+		{"valid", `{"voices": [{"id": "a", "enabled": true}, {"id": "b"}]}`, ""},
+		{"not json", `voices: []`, "invalid character"},
+		{"missing id", `{"voices": [{"name": "Nobody", "enabled": true}]}`, `voice "Nobody" has no id`},
+		{"duplicate id", `{"voices": [{"id": "a", "enabled": true}, {"id": "a"}]}`, "duplicate voice id a"},
+		{"none enabled", `{"voices": [{"id": "a"}, {"id": "b"}]}`, "no enabled voices"},
+		{"empty", `{}`, "no enabled voices"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCatalog([]byte(tt.data))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("err = %v, want valid", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuiltInCatalog(t *testing.T) {
+	r, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.enabled()) == 0 {
+		t.Error("built-in catalog has no enabled voices")
+	}
+	if reloaded, err := r.Reload(); reloaded || err != nil {
+		t.Errorf("Reload of the built-in catalog = %v, %v; want no-op", reloaded, err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "voices.json")
+	modTime := time.Now().Add(-time.Hour)
+
+	// write replaces the file and moves its modification time on, as an
+	// edit would
+	write := func(data string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		modTime = modTime.Add(time.Minute)
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := func(r *Registry) string {
+		var ids []string
+		for _, v := range r.Voices() {
+			ids = append(ids, v.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	write(`{"voices": [{"id": "a", "enabled": true}]}`)
+	r, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reloaded, err := r.Reload(); reloaded || err != nil {
+		t.Errorf("Reload of an unchanged file = %v, %v; want no-op", reloaded, err)
+	}
+
+	write(`{"voices": [{"id": "a", "enabled": true}, {"id": "b", "enabled": true}]}`)
+	if reloaded, err := r.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload of a changed file = %v, %v", reloaded, err)
+	}
+	if got := ids(r); got != "a,b" {
+		t.Errorf("voices = %s, want a,b", got)
+	}
+
+	// A broken edit keeps the voices in use
+	write(`{"voices": [{"id": "a"}]}`)
+	if reloaded, err := r.Reload(); reloaded || err == nil {
+		t.Errorf("Reload of an invalid file = %v, %v; want an error", reloaded, err)
+	}
+	if got := ids(r); got != "a,b" {
+		t.Errorf("voices after an invalid reload = %s, want a,b", got)
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reload(); err == nil {
+		t.Error("Reload of a missing file succeeded")
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load of a missing file succeeded")
+	}
+}
+
+func TestEmotionSettings(t *testing.T) {
+	c, err := parseCatalog([]byte(`{
+		"emotions": {"furious": {"stability": 0.3, "style": 0.5}},
+		"models": {"v2": {"furious": {"style": 0.7}}},
+		"voices": [{
+			"id": "a", "enabled": true,
+			"emotions": {"furious": {"stability": 0.2}},
+			"models": {"v2": {"furious": {"use_speaker_boost": true}}}
+		}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Registry{catalog: c}
+
+	tests := []struct {
+		name    string
+		voiceID string
+		model   string
+		emotion string
+		want    map[string]interface{}
+	}{
+		{"no overrides", "a", "v2", "calm", nil},
+		{"catalog only", "other", "v1", "furious", map[string]interface{}{"stability": 0.3, "style": 0.5}},
+		{"model over catalog", "other", "v2", "furious", map[string]interface{}{"stability": 0.3, "style": 0.7}},
+		{"voice over catalog", "a", "v1", "furious", map[string]interface{}{"stability": 0.2, "style": 0.5}},
+		{"every layer", "a", "v2", "furious", map[string]interface{}{"stability": 0.2, "style": 0.7, "use_speaker_boost": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.EmotionSettings(tt.voiceID, tt.model, tt.emotion)
+			if len(got) != len(tt.want) || (tt.want == nil) != (got == nil) {
+				t.Fatalf("EmotionSettings = %v, want %v", got, tt.want)
+			}
+			for key, value := range tt.want {
+				if got[key] != value {
+					t.Errorf("%s = %v, want %v", key, got[key], value)
+				}
+			}
+		})
+	}
+}
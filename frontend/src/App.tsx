@@ -3,8 +3,13 @@ import SessionStart from './components/SessionStart';
 import GameDesk from './components/GameDesk';
 import AudioControls from './components/AudioControls';
 import { audioManager } from './audio/AudioManager';
+import { gameClient, playerId } from './api/client';
+import type { ResumeSessionResponse } from './gen/game/v1/game_pb';
 import './App.css';
 
+// Survives reloads so an unfinished shift can be resumed
+const SESSION_STORAGE_KEY = 'sendMeHome.sessionId';
+
 type GameState = 'start' | 'playing' | 'complete';
 
 interface SessionData {
@@ -12,6 +17,7 @@ interface SessionData {
   gameDate: string;
   rules: string[];
   totalCases: number;
+  resumed?: ResumeSessionResponse; // Set when continuing a shift after a reload
 }
 
 interface CompletionStats {
@@ -57,12 +63,48 @@ function App() {
     };
   }, [audioInitialized]);
 
+  useEffect(() => {
+    // Resume an unfinished shift after a reload
+    const savedSessionId = localStorage.getItem(SESSION_STORAGE_KEY);
+    if (!savedSessionId) {
+      return;
+    }
+
+    const resume = async () => {
+      try {
+        const response = await gameClient.resumeSession({
+          sessionId: savedSessionId,
+          playerId: playerId(),
+        });
+        if (response.sessionComplete) {
+          localStorage.removeItem(SESSION_STORAGE_KEY);
+          return;
+        }
+        setSessionData({
+          sessionId: response.sessionId,
+          gameDate: response.gameDate,
+          rules: response.rules,
+          totalCases: response.totalCases,
+          resumed: response,
+        });
+        setGameState('playing');
+      } catch (error) {
+        console.error('Failed to resume session:', error);
+        localStorage.removeItem(SESSION_STORAGE_KEY);
+      }
+    };
+
+    resume();
+  }, []);
+
   const handleSessionReady = (data: SessionData) => {
+    localStorage.setItem(SESSION_STORAGE_KEY, data.sessionId);
     setSessionData(data);
     setGameState('playing');
   };
 
   const handleSessionComplete = (stats: CompletionStats) => {
+    localStorage.removeItem(SESSION_STORAGE_KEY);
     setCompletionStats(stats);
     setGameState('complete');
   };
@@ -87,6 +129,7 @@ function App() {
             gameDate={sessionData.gameDate}
             rules={sessionData.rules}
             totalCases={sessionData.totalCases}
+            resumed={sessionData.resumed}
             onComplete={handleSessionComplete}
           />
         )}
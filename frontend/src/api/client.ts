@@ -9,3 +9,16 @@ const transport = createConnectTransport({
 
 // Create typed client
 export const gameClient = createClient(GameService, transport);
+
+// Identifies this browser to the backend, so that its sessions can only be
+// resumed from here
+const PLAYER_STORAGE_KEY = "sendMeHome.playerId";
+
+export function playerId(): string {
+  let id = localStorage.getItem(PLAYER_STORAGE_KEY);
+  if (!id) {
+    id = crypto.randomUUID();
+    localStorage.setItem(PLAYER_STORAGE_KEY, id);
+  }
+  return id;
+}
@@ -1,7 +1,7 @@
 import { useEffect, useRef, useState } from 'react';
 import { gameClient } from '../api/client';
 import { audioManager } from '../audio/AudioManager';
-import type { Document, NPCProfile } from '../gen/game/v1/game_pb';
+import type { DialogueLine, Document, GetNextCaseResponse, NPCProfile, ResumeSessionResponse } from '../gen/game/v1/game_pb';
 import { Decision } from '../gen/game/v1/game_pb';
 import './GameDesk.css';
 
@@ -10,6 +10,7 @@ interface GameDeskProps {
   gameDate: string;
   rules: string[];
   totalCases: number;
+  resumed?: ResumeSessionResponse; // Restores the case in progress after a reload
   onComplete: (stats: {
     totalScore: number;
     correct: number;
@@ -27,18 +28,26 @@ interface CaseData {
   remainingSecondaryChecks: number;
 }
 
-function GameDesk({ sessionId, gameDate, rules, totalCases, onComplete }: GameDeskProps) {
+function GameDesk({ sessionId, gameDate, rules, totalCases, resumed, onComplete }: GameDeskProps) {
   const [currentCase, setCurrentCase] = useState<CaseData | null>(null);
   const [score, setScore] = useState(0);
   const [question, setQuestion] = useState('');
   const [npcResponse, setNpcResponse] = useState('');
+  const [transcript, setTranscript] = useState<DialogueLine[]>([]);
   const [verdict, setVerdict] = useState('');
   const [showVerdict, setShowVerdict] = useState(false);
   const [loading, setLoading] = useState(false);
   const questionInputRef = useRef<HTMLInputElement>(null);
 
   useEffect(() => {
-    loadNextCase();
+    // Pick up where the player left off, or fetch the next case
+    if (resumed?.currentCase) {
+      setScore(resumed.totalScore);
+      setTranscript(resumed.transcript);
+      showCase(resumed.currentCase);
+    } else {
+      loadNextCase();
+    }
   }, []);
 
   // Space bar handler for quick access to question input
@@ -54,33 +63,37 @@ function GameDesk({ sessionId, gameDate, rules, totalCases, onComplete }: GameDe
     return () => document.removeEventListener('keydown', handleKeyDown);
   }, [showVerdict]);
 
+  const showCase = (response: GetNextCaseResponse) => {
+    setCurrentCase({
+      caseId: response.caseId,
+      npc: response.npc!,
+      documents: response.documents,
+      openingLine: response.openingLine,
+      caseNumber: response.caseNumber,
+      remainingSecondaryChecks: response.remainingSecondaryChecks,
+    });
+
+    audioManager.playNewCaseSound();
+
+    // Play opening audio if available
+    if (response.openingAudio && response.openingAudio.length > 0) {
+      const audioBlob = new Blob([new Uint8Array(response.openingAudio)], { type: response.openingAudioMimeType || 'audio/mpeg' });
+      const audioUrl = URL.createObjectURL(audioBlob);
+      const audio = new Audio(audioUrl);
+      audio.play().catch((err) => console.error('Failed to play opening audio:', err));
+    }
+  };
+
   const loadNextCase = async () => {
     setLoading(true);
     setShowVerdict(false);
     setVerdict('');
     setNpcResponse('');
+    setTranscript([]);
 
     try {
-      const response: any = await gameClient.getNextCase({ sessionId });
-
-      setCurrentCase({
-        caseId: response.caseId,
-        npc: response.npc!,
-        documents: response.documents,
-        openingLine: response.openingLine,
-        caseNumber: response.caseNumber,
-        remainingSecondaryChecks: response.remainingSecondaryChecks,
-      });
-
-      audioManager.playNewCaseSound();
-
-      // Play opening audio if available
-      if (response.openingAudio && response.openingAudio.length > 0) {
-        const audioBlob = new Blob([response.openingAudio], { type: 'audio/mpeg' });
-        const audioUrl = URL.createObjectURL(audioBlob);
-        const audio = new Audio(audioUrl);
-        audio.play().catch((err) => console.error('Failed to play opening audio:', err));
-      }
+      const response = await gameClient.getNextCase({ sessionId });
+      showCase(response);
     } catch (error: any) {
       if (error.message?.includes('no more cases')) {
         // Fetch final session stats
@@ -332,6 +345,12 @@ function GameDesk({ sessionId, gameDate, rules, totalCases, onComplete }: GameDe
             {/* Dialogue Box */}
             <div className="dialogue-box">
               <div className="dialogue-text">{currentCase.openingLine}</div>
+              {transcript.map((line, i) => (
+                <div key={i}>
+                  <div className="dialogue-text dialogue-question">{line.question}</div>
+                  <div className="dialogue-text dialogue-response">{line.answer}</div>
+                </div>
+              ))}
               {npcResponse && (
                 <div className="dialogue-text dialogue-response">{npcResponse}</div>
               )}
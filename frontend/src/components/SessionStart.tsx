@@ -1,5 +1,5 @@
 import { useState } from 'react';
-import { gameClient } from '../api/client';
+import { gameClient, playerId } from '../api/client';
 import { audioManager } from '../audio/AudioManager';
 import './SessionStart.css';
 
@@ -21,7 +21,7 @@ function SessionStart({ onSessionReady }: SessionStartProps) {
     setProgress({ current: 0, total: 0, message: 'Initializing...' });
 
     try {
-      const stream: any = gameClient.startSession({ numCases: 15 });
+      const stream: any = gameClient.startSession({ numCases: 15, playerId: playerId() });
 
       // Stream session start progress
       for await (const response of stream) {
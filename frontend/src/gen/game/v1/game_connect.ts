@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AskQuestionRequest, AskQuestionResponse, GetNextCaseRequest, GetNextCaseResponse, GetSessionStatusRequest, GetSessionStatusResponse, ResolveCaseRequest, ResolveCaseResponse, SecondaryCheckRequest, SecondaryCheckResponse, StartSessionRequest, StartSessionResponse } from "./game_pb";
+import { AskQuestionRequest, AskQuestionResponse, CancelSessionGenerationRequest, CancelSessionGenerationResponse, GetCampaignRequest, GetCampaignResponse, GetNextCaseRequest, GetNextCaseResponse, GetSessionStatusRequest, GetSessionStatusResponse, GetShiftReportRequest, GetShiftReportResponse, GetUsageRequest, GetUsageResponse, InterrogateRequest, InterrogateResponse, ListMySessionsRequest, ListMySessionsResponse, ListVoicesRequest, ListVoicesResponse, ResolveCaseRequest, ResolveCaseResponse, ResumeSessionRequest, ResumeSessionResponse, SecondaryCheckRequest, SecondaryCheckResponse, StartSessionGenerationResponse, StartSessionRequest, StartSessionResponse, VoiceQuestionRequest, WatchSessionGenerationRequest } from "./game_pb";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -26,7 +26,40 @@ export const GameService = {
       kind: MethodKind.ServerStreaming,
     },
     /**
-     * Get next case from pre-generated queue
+     * Start generating a session in the background and return its job ID
+     *
+     * @generated from rpc game.v1.GameService.StartSessionGeneration
+     */
+    startSessionGeneration: {
+      name: "StartSessionGeneration",
+      I: StartSessionRequest,
+      O: StartSessionGenerationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Stream a generation job's progress; reconnecting picks up where it left off
+     *
+     * @generated from rpc game.v1.GameService.WatchSessionGeneration
+     */
+    watchSessionGeneration: {
+      name: "WatchSessionGeneration",
+      I: WatchSessionGenerationRequest,
+      O: StartSessionResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Cancel a running generation job
+     *
+     * @generated from rpc game.v1.GameService.CancelSessionGeneration
+     */
+    cancelSessionGeneration: {
+      name: "CancelSessionGeneration",
+      I: CancelSessionGenerationRequest,
+      O: CancelSessionGenerationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get next case from pre-generated queue (generated on demand in adaptive mode)
      *
      * @generated from rpc game.v1.GameService.GetNextCase
      */
@@ -47,6 +80,28 @@ export const GameService = {
       O: AskQuestionResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Ask a question by voice: stream microphone audio, get back the transcript and the NPC's answer
+     *
+     * @generated from rpc game.v1.GameService.AskQuestionByVoice
+     */
+    askQuestionByVoice: {
+      name: "AskQuestionByVoice",
+      I: VoiceQuestionRequest,
+      O: AskQuestionResponse,
+      kind: MethodKind.BiDiStreaming,
+    },
+    /**
+     * Live conversation with the NPC of one case: a new question or an interrupt cancels the answer in progress
+     *
+     * @generated from rpc game.v1.GameService.Interrogate
+     */
+    interrogate: {
+      name: "Interrogate",
+      I: InterrogateRequest,
+      O: InterrogateResponse,
+      kind: MethodKind.BiDiStreaming,
+    },
     /**
      * Use secondary check (limited quota)
      *
@@ -80,6 +135,72 @@ export const GameService = {
       O: GetSessionStatusResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Get a player's multi-day campaign progress
+     *
+     * @generated from rpc game.v1.GameService.GetCampaign
+     */
+    getCampaign: {
+      name: "GetCampaign",
+      I: GetCampaignRequest,
+      O: GetCampaignResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the end-of-shift debrief with a per-case breakdown
+     *
+     * @generated from rpc game.v1.GameService.GetShiftReport
+     */
+    getShiftReport: {
+      name: "GetShiftReport",
+      I: GetShiftReportRequest,
+      O: GetShiftReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Resume a session after a reload or disconnect
+     *
+     * @generated from rpc game.v1.GameService.ResumeSession
+     */
+    resumeSession: {
+      name: "ResumeSession",
+      I: ResumeSessionRequest,
+      O: ResumeSessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List a player's sessions, newest first
+     *
+     * @generated from rpc game.v1.GameService.ListMySessions
+     */
+    listMySessions: {
+      name: "ListMySessions",
+      I: ListMySessionsRequest,
+      O: ListMySessionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Admin: list the voice catalog (requires the admin token)
+     *
+     * @generated from rpc game.v1.GameService.ListVoices
+     */
+    listVoices: {
+      name: "ListVoices",
+      I: ListVoicesRequest,
+      O: ListVoicesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Admin: AI usage and estimated cost for the server, a session or a player
+     *
+     * @generated from rpc game.v1.GameService.GetUsage
+     */
+    getUsage: {
+      name: "GetUsage",
+      I: GetUsageRequest,
+      O: GetUsageResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,73 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * Audio codecs clients can ask for. Unspecified means MP3 at 128 kbps.
+ * Servers without a codec fall back to one they have and report it in the
+ * MIME type that comes with the audio.
+ *
+ * @generated from enum game.v1.AudioFormat
+ */
+export enum AudioFormat {
+  /**
+   * @generated from enum value: AUDIO_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 44.1 kHz, 64 kbps
+   *
+   * @generated from enum value: AUDIO_FORMAT_MP3_64 = 1;
+   */
+  MP3_64 = 1,
+
+  /**
+   * 44.1 kHz, 128 kbps
+   *
+   * @generated from enum value: AUDIO_FORMAT_MP3_128 = 2;
+   */
+  MP3_128 = 2,
+
+  /**
+   * 44.1 kHz, 192 kbps
+   *
+   * @generated from enum value: AUDIO_FORMAT_MP3_192 = 3;
+   */
+  MP3_192 = 3,
+
+  /**
+   * Ogg Opus, 48 kHz, 64 kbps
+   *
+   * @generated from enum value: AUDIO_FORMAT_OPUS = 4;
+   */
+  OPUS = 4,
+
+  /**
+   * Raw 16-bit little-endian mono PCM at 16 kHz
+   *
+   * @generated from enum value: AUDIO_FORMAT_PCM_16000 = 5;
+   */
+  PCM_16000 = 5,
+
+  /**
+   * Raw 16-bit little-endian mono PCM at 24 kHz
+   *
+   * @generated from enum value: AUDIO_FORMAT_PCM_24000 = 6;
+   */
+  PCM_24000 = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AudioFormat)
+proto3.util.setEnumType(AudioFormat, "game.v1.AudioFormat", [
+  { no: 0, name: "AUDIO_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "AUDIO_FORMAT_MP3_64" },
+  { no: 2, name: "AUDIO_FORMAT_MP3_128" },
+  { no: 3, name: "AUDIO_FORMAT_MP3_192" },
+  { no: 4, name: "AUDIO_FORMAT_OPUS" },
+  { no: 5, name: "AUDIO_FORMAT_PCM_16000" },
+  { no: 6, name: "AUDIO_FORMAT_PCM_24000" },
+]);
 
 /**
  * @generated from enum game.v1.CaseOutcome
@@ -93,6 +159,34 @@ export class StartSessionRequest extends Message<StartSessionRequest> {
    */
   numCases = 0;
 
+  /**
+   * Generate upcoming cases lazily, tuned to player accuracy
+   *
+   * @generated from field: bool adaptive = 2;
+   */
+  adaptive = false;
+
+  /**
+   * Stable client-generated player identifier
+   *
+   * @generated from field: string player_id = 3;
+   */
+  playerId = "";
+
+  /**
+   * Start the player's next campaign day instead of a standalone shift
+   *
+   * @generated from field: bool campaign = 4;
+   */
+  campaign = false;
+
+  /**
+   * Return after the first cases; generate the rest in the background ahead of the player
+   *
+   * @generated from field: bool pipeline = 5;
+   */
+  pipeline = false;
+
   constructor(data?: PartialMessage<StartSessionRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -102,6 +196,10 @@ export class StartSessionRequest extends Message<StartSessionRequest> {
   static readonly typeName = "game.v1.StartSessionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "num_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "adaptive", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "campaign", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "pipeline", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartSessionRequest {
@@ -192,6 +290,13 @@ export class SessionProgress extends Message<SessionProgress> {
    */
   message = "";
 
+  /**
+   * Pass to WatchSessionGeneration to reconnect
+   *
+   * @generated from field: string job_id = 4;
+   */
+  jobId = "";
+
   constructor(data?: PartialMessage<SessionProgress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -203,6 +308,7 @@ export class SessionProgress extends Message<SessionProgress> {
     { no: 1, name: "current", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 2, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionProgress {
@@ -257,6 +363,27 @@ export class SessionReady extends Message<SessionReady> {
    */
   secondaryChecksQuota = 0;
 
+  /**
+   * 0 for standalone shifts
+   *
+   * @generated from field: int32 campaign_day = 6;
+   */
+  campaignDay = 0;
+
+  /**
+   * Today's campaign storyline event
+   *
+   * @generated from field: string story_event = 7;
+   */
+  storyEvent = "";
+
+  /**
+   * Real time until the shuttle departs, 0 if untimed
+   *
+   * @generated from field: int32 shift_length_seconds = 8;
+   */
+  shiftLengthSeconds = 0;
+
   constructor(data?: PartialMessage<SessionReady>) {
     super();
     proto3.util.initPartial(data, this);
@@ -270,6 +397,9 @@ export class SessionReady extends Message<SessionReady> {
     { no: 3, name: "rules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 4, name: "total_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 5, name: "secondary_checks_quota", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "campaign_day", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "story_event", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "shift_length_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionReady {
@@ -289,6 +419,156 @@ export class SessionReady extends Message<SessionReady> {
   }
 }
 
+/**
+ * @generated from message game.v1.StartSessionGenerationResponse
+ */
+export class StartSessionGenerationResponse extends Message<StartSessionGenerationResponse> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<StartSessionGenerationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.StartSessionGenerationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartSessionGenerationResponse {
+    return new StartSessionGenerationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartSessionGenerationResponse {
+    return new StartSessionGenerationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartSessionGenerationResponse {
+    return new StartSessionGenerationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartSessionGenerationResponse | PlainMessage<StartSessionGenerationResponse> | undefined, b: StartSessionGenerationResponse | PlainMessage<StartSessionGenerationResponse> | undefined): boolean {
+    return proto3.util.equals(StartSessionGenerationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.WatchSessionGenerationRequest
+ */
+export class WatchSessionGenerationRequest extends Message<WatchSessionGenerationRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<WatchSessionGenerationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.WatchSessionGenerationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchSessionGenerationRequest {
+    return new WatchSessionGenerationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchSessionGenerationRequest {
+    return new WatchSessionGenerationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchSessionGenerationRequest {
+    return new WatchSessionGenerationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchSessionGenerationRequest | PlainMessage<WatchSessionGenerationRequest> | undefined, b: WatchSessionGenerationRequest | PlainMessage<WatchSessionGenerationRequest> | undefined): boolean {
+    return proto3.util.equals(WatchSessionGenerationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.CancelSessionGenerationRequest
+ */
+export class CancelSessionGenerationRequest extends Message<CancelSessionGenerationRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<CancelSessionGenerationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.CancelSessionGenerationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelSessionGenerationRequest {
+    return new CancelSessionGenerationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelSessionGenerationRequest {
+    return new CancelSessionGenerationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelSessionGenerationRequest {
+    return new CancelSessionGenerationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelSessionGenerationRequest | PlainMessage<CancelSessionGenerationRequest> | undefined, b: CancelSessionGenerationRequest | PlainMessage<CancelSessionGenerationRequest> | undefined): boolean {
+    return proto3.util.equals(CancelSessionGenerationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.CancelSessionGenerationResponse
+ */
+export class CancelSessionGenerationResponse extends Message<CancelSessionGenerationResponse> {
+  /**
+   * False if the job had already finished
+   *
+   * @generated from field: bool cancelled = 1;
+   */
+  cancelled = false;
+
+  constructor(data?: PartialMessage<CancelSessionGenerationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.CancelSessionGenerationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cancelled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelSessionGenerationResponse {
+    return new CancelSessionGenerationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelSessionGenerationResponse {
+    return new CancelSessionGenerationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelSessionGenerationResponse {
+    return new CancelSessionGenerationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelSessionGenerationResponse | PlainMessage<CancelSessionGenerationResponse> | undefined, b: CancelSessionGenerationResponse | PlainMessage<CancelSessionGenerationResponse> | undefined): boolean {
+    return proto3.util.equals(CancelSessionGenerationResponse, a, b);
+  }
+}
+
 /**
  * @generated from message game.v1.GetNextCaseRequest
  */
@@ -298,6 +578,13 @@ export class GetNextCaseRequest extends Message<GetNextCaseRequest> {
    */
   sessionId = "";
 
+  /**
+   * Preferred codec for opening_audio
+   *
+   * @generated from field: game.v1.AudioFormat audio_format = 2;
+   */
+  audioFormat = AudioFormat.UNSPECIFIED;
+
   constructor(data?: PartialMessage<GetNextCaseRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -307,6 +594,7 @@ export class GetNextCaseRequest extends Message<GetNextCaseRequest> {
   static readonly typeName = "game.v1.GetNextCaseRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "audio_format", kind: "enum", T: proto3.getEnumType(AudioFormat) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNextCaseRequest {
@@ -351,7 +639,7 @@ export class GetNextCaseResponse extends Message<GetNextCaseResponse> {
   openingLine = "";
 
   /**
-   * Pre-generated MP3
+   * Pre-generated audio
    *
    * @generated from field: bytes opening_audio = 5;
    */
@@ -369,6 +657,34 @@ export class GetNextCaseResponse extends Message<GetNextCaseResponse> {
    */
   remainingSecondaryChecks = 0;
 
+  /**
+   * Server-side shift clock
+   *
+   * @generated from field: int32 remaining_shift_seconds = 8;
+   */
+  remainingShiftSeconds = 0;
+
+  /**
+   * Word timings for opening_audio
+   *
+   * @generated from field: game.v1.SubtitleTrack opening_subtitles = 9;
+   */
+  openingSubtitles?: SubtitleTrack;
+
+  /**
+   * e.g. "audio/mpeg"
+   *
+   * @generated from field: string opening_audio_mime_type = 10;
+   */
+  openingAudioMimeType = "";
+
+  /**
+   * The case is placeholder content because generation failed
+   *
+   * @generated from field: bool degraded = 11;
+   */
+  degraded = false;
+
   constructor(data?: PartialMessage<GetNextCaseResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -384,6 +700,10 @@ export class GetNextCaseResponse extends Message<GetNextCaseResponse> {
     { no: 5, name: "opening_audio", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 6, name: "case_number", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 7, name: "remaining_secondary_checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "remaining_shift_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "opening_subtitles", kind: "message", T: SubtitleTrack },
+    { no: 10, name: "opening_audio_mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "degraded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNextCaseResponse {
@@ -403,6 +723,102 @@ export class GetNextCaseResponse extends Message<GetNextCaseResponse> {
   }
 }
 
+/**
+ * Word timings for karaoke-style subtitles
+ *
+ * @generated from message game.v1.SubtitleTrack
+ */
+export class SubtitleTrack extends Message<SubtitleTrack> {
+  /**
+   * @generated from field: repeated game.v1.SubtitleWord words = 1;
+   */
+  words: SubtitleWord[] = [];
+
+  constructor(data?: PartialMessage<SubtitleTrack>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.SubtitleTrack";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "words", kind: "message", T: SubtitleWord, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubtitleTrack {
+    return new SubtitleTrack().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubtitleTrack {
+    return new SubtitleTrack().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubtitleTrack {
+    return new SubtitleTrack().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubtitleTrack | PlainMessage<SubtitleTrack> | undefined, b: SubtitleTrack | PlainMessage<SubtitleTrack> | undefined): boolean {
+    return proto3.util.equals(SubtitleTrack, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.SubtitleWord
+ */
+export class SubtitleWord extends Message<SubtitleWord> {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text = "";
+
+  /**
+   * Character offset of the word in the spoken line
+   *
+   * @generated from field: int32 offset = 2;
+   */
+  offset = 0;
+
+  /**
+   * @generated from field: int32 start_ms = 3;
+   */
+  startMs = 0;
+
+  /**
+   * @generated from field: int32 end_ms = 4;
+   */
+  endMs = 0;
+
+  constructor(data?: PartialMessage<SubtitleWord>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.SubtitleWord";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "offset", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "start_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "end_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubtitleWord {
+    return new SubtitleWord().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubtitleWord {
+    return new SubtitleWord().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubtitleWord {
+    return new SubtitleWord().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubtitleWord | PlainMessage<SubtitleWord> | undefined, b: SubtitleWord | PlainMessage<SubtitleWord> | undefined): boolean {
+    return proto3.util.equals(SubtitleWord, a, b);
+  }
+}
+
 /**
  * @generated from message game.v1.AskQuestionRequest
  */
@@ -422,6 +838,13 @@ export class AskQuestionRequest extends Message<AskQuestionRequest> {
    */
   question = "";
 
+  /**
+   * Preferred codec for the answer audio
+   *
+   * @generated from field: game.v1.AudioFormat audio_format = 4;
+   */
+  audioFormat = AudioFormat.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AskQuestionRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -433,6 +856,7 @@ export class AskQuestionRequest extends Message<AskQuestionRequest> {
     { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "question", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "audio_format", kind: "enum", T: proto3.getEnumType(AudioFormat) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskQuestionRequest {
@@ -481,8 +905,39 @@ export class AskQuestionResponse extends Message<AskQuestionResponse> {
      */
     value: boolean;
     case: "done";
+  } | {
+    /**
+     * Recognized text of a voice question, sent before the answer
+     *
+     * @generated from field: string transcript = 4;
+     */
+    value: string;
+    case: "transcript";
+  } | {
+    /**
+     * Word timings for the audio chunk, sent right after it
+     *
+     * @generated from field: game.v1.SubtitleTrack subtitles = 5;
+     */
+    value: SubtitleTrack;
+    case: "subtitles";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * Set with audio_chunk, e.g. "audio/mpeg"
+   *
+   * @generated from field: string audio_mime_type = 6;
+   */
+  audioMimeType = "";
+
+  /**
+   * Set with text_chunk when the answer is a fallback line rather than the
+   * model's, and with done when the text or the audio fell back
+   *
+   * @generated from field: bool degraded = 7;
+   */
+  degraded = false;
+
   constructor(data?: PartialMessage<AskQuestionResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -494,6 +949,10 @@ export class AskQuestionResponse extends Message<AskQuestionResponse> {
     { no: 1, name: "text_chunk", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
     { no: 2, name: "audio_chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "chunk" },
     { no: 3, name: "done", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "chunk" },
+    { no: 4, name: "transcript", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
+    { no: 5, name: "subtitles", kind: "message", T: SubtitleTrack, oneof: "chunk" },
+    { no: 6, name: "audio_mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "degraded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskQuestionResponse {
@@ -514,16 +973,385 @@ export class AskQuestionResponse extends Message<AskQuestionResponse> {
 }
 
 /**
- * @generated from message game.v1.SecondaryCheckRequest
+ * The first message must be start; audio chunks follow until the client
+ * closes its side of the stream
+ *
+ * @generated from message game.v1.VoiceQuestionRequest
  */
-export class SecondaryCheckRequest extends Message<SecondaryCheckRequest> {
-  /**
-   * @generated from field: string session_id = 1;
-   */
-  sessionId = "";
-
+export class VoiceQuestionRequest extends Message<VoiceQuestionRequest> {
   /**
-   * @generated from field: string case_id = 2;
+   * @generated from oneof game.v1.VoiceQuestionRequest.input
+   */
+  input: {
+    /**
+     * @generated from field: game.v1.VoiceQuestionStart start = 1;
+     */
+    value: VoiceQuestionStart;
+    case: "start";
+  } | {
+    /**
+     * Microphone audio
+     *
+     * @generated from field: bytes audio_chunk = 2;
+     */
+    value: Uint8Array;
+    case: "audioChunk";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<VoiceQuestionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.VoiceQuestionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "message", T: VoiceQuestionStart, oneof: "input" },
+    { no: 2, name: "audio_chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "input" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoiceQuestionRequest {
+    return new VoiceQuestionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoiceQuestionRequest {
+    return new VoiceQuestionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoiceQuestionRequest {
+    return new VoiceQuestionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoiceQuestionRequest | PlainMessage<VoiceQuestionRequest> | undefined, b: VoiceQuestionRequest | PlainMessage<VoiceQuestionRequest> | undefined): boolean {
+    return proto3.util.equals(VoiceQuestionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.VoiceQuestionStart
+ */
+export class VoiceQuestionStart extends Message<VoiceQuestionStart> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string case_id = 2;
+   */
+  caseId = "";
+
+  /**
+   * e.g. "audio/webm" or "audio/wav"
+   *
+   * @generated from field: string mime_type = 3;
+   */
+  mimeType = "";
+
+  /**
+   * Preferred codec for the answer audio
+   *
+   * @generated from field: game.v1.AudioFormat audio_format = 4;
+   */
+  audioFormat = AudioFormat.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<VoiceQuestionStart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.VoiceQuestionStart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "audio_format", kind: "enum", T: proto3.getEnumType(AudioFormat) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoiceQuestionStart {
+    return new VoiceQuestionStart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoiceQuestionStart {
+    return new VoiceQuestionStart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoiceQuestionStart {
+    return new VoiceQuestionStart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoiceQuestionStart | PlainMessage<VoiceQuestionStart> | undefined, b: VoiceQuestionStart | PlainMessage<VoiceQuestionStart> | undefined): boolean {
+    return proto3.util.equals(VoiceQuestionStart, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.InterrogateRequest
+ */
+export class InterrogateRequest extends Message<InterrogateRequest> {
+  /**
+   * @generated from oneof game.v1.InterrogateRequest.input
+   */
+  input: {
+    /**
+     * Must be the first message
+     *
+     * @generated from field: game.v1.InterrogateStart start = 1;
+     */
+    value: InterrogateStart;
+    case: "start";
+  } | {
+    /**
+     * Typed question
+     *
+     * @generated from field: string question = 2;
+     */
+    value: string;
+    case: "question";
+  } | {
+    /**
+     * Part of a spoken question
+     *
+     * @generated from field: bytes audio_chunk = 3;
+     */
+    value: Uint8Array;
+    case: "audioChunk";
+  } | {
+    /**
+     * The spoken question is complete
+     *
+     * @generated from field: bool audio_end = 4;
+     */
+    value: boolean;
+    case: "audioEnd";
+  } | {
+    /**
+     * Stop the answer in progress
+     *
+     * @generated from field: bool interrupt = 5;
+     */
+    value: boolean;
+    case: "interrupt";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<InterrogateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.InterrogateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "message", T: InterrogateStart, oneof: "input" },
+    { no: 2, name: "question", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "input" },
+    { no: 3, name: "audio_chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "input" },
+    { no: 4, name: "audio_end", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "input" },
+    { no: 5, name: "interrupt", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "input" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InterrogateRequest {
+    return new InterrogateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InterrogateRequest {
+    return new InterrogateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InterrogateRequest {
+    return new InterrogateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InterrogateRequest | PlainMessage<InterrogateRequest> | undefined, b: InterrogateRequest | PlainMessage<InterrogateRequest> | undefined): boolean {
+    return proto3.util.equals(InterrogateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.InterrogateStart
+ */
+export class InterrogateStart extends Message<InterrogateStart> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string case_id = 2;
+   */
+  caseId = "";
+
+  /**
+   * Audio format of spoken questions, e.g. "audio/webm"
+   *
+   * @generated from field: string mime_type = 3;
+   */
+  mimeType = "";
+
+  /**
+   * Preferred codec for the answer audio
+   *
+   * @generated from field: game.v1.AudioFormat audio_format = 4;
+   */
+  audioFormat = AudioFormat.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<InterrogateStart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.InterrogateStart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "audio_format", kind: "enum", T: proto3.getEnumType(AudioFormat) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InterrogateStart {
+    return new InterrogateStart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InterrogateStart {
+    return new InterrogateStart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InterrogateStart {
+    return new InterrogateStart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InterrogateStart | PlainMessage<InterrogateStart> | undefined, b: InterrogateStart | PlainMessage<InterrogateStart> | undefined): boolean {
+    return proto3.util.equals(InterrogateStart, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.InterrogateResponse
+ */
+export class InterrogateResponse extends Message<InterrogateResponse> {
+  /**
+   * Question this chunk belongs to, counting from 1
+   *
+   * @generated from field: int32 turn = 1;
+   */
+  turn = 0;
+
+  /**
+   * @generated from oneof game.v1.InterrogateResponse.chunk
+   */
+  chunk: {
+    /**
+     * Recognized text of a spoken question
+     *
+     * @generated from field: string transcript = 2;
+     */
+    value: string;
+    case: "transcript";
+  } | {
+    /**
+     * NPC answer text
+     *
+     * @generated from field: string text_chunk = 3;
+     */
+    value: string;
+    case: "textChunk";
+  } | {
+    /**
+     * Part of the NPC answer audio; concatenate the chunks of a turn
+     *
+     * @generated from field: bytes audio_chunk = 4;
+     */
+    value: Uint8Array;
+    case: "audioChunk";
+  } | {
+    /**
+     * The answer is complete
+     *
+     * @generated from field: bool done = 5;
+     */
+    value: boolean;
+    case: "done";
+  } | {
+    /**
+     * The answer was cut off by a new question or an interrupt
+     *
+     * @generated from field: bool interrupted = 6;
+     */
+    value: boolean;
+    case: "interrupted";
+  } | {
+    /**
+     * The question couldn't be answered; the conversation continues
+     *
+     * @generated from field: string error = 7;
+     */
+    value: string;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * Set with audio_chunk, e.g. "audio/mpeg"
+   *
+   * @generated from field: string audio_mime_type = 8;
+   */
+  audioMimeType = "";
+
+  /**
+   * Set with text_chunk when the answer is a fallback line rather than the
+   * model's, and with done when the text or the audio fell back
+   *
+   * @generated from field: bool degraded = 9;
+   */
+  degraded = false;
+
+  constructor(data?: PartialMessage<InterrogateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.InterrogateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "transcript", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
+    { no: 3, name: "text_chunk", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
+    { no: 4, name: "audio_chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "chunk" },
+    { no: 5, name: "done", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "chunk" },
+    { no: 6, name: "interrupted", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "chunk" },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
+    { no: 8, name: "audio_mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "degraded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InterrogateResponse {
+    return new InterrogateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InterrogateResponse {
+    return new InterrogateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InterrogateResponse {
+    return new InterrogateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InterrogateResponse | PlainMessage<InterrogateResponse> | undefined, b: InterrogateResponse | PlainMessage<InterrogateResponse> | undefined): boolean {
+    return proto3.util.equals(InterrogateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.SecondaryCheckRequest
+ */
+export class SecondaryCheckRequest extends Message<SecondaryCheckRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string case_id = 2;
    */
   caseId = "";
 
@@ -634,6 +1462,20 @@ export class ResolveCaseRequest extends Message<ResolveCaseRequest> {
    */
   decision = Decision.UNSPECIFIED;
 
+  /**
+   * Fields the player marked as violating the rules
+   *
+   * @generated from field: repeated game.v1.FlaggedField flagged_fields = 4;
+   */
+  flaggedFields: FlaggedField[] = [];
+
+  /**
+   * Preferred codec for npc_reaction_audio
+   *
+   * @generated from field: game.v1.AudioFormat audio_format = 5;
+   */
+  audioFormat = AudioFormat.UNSPECIFIED;
+
   constructor(data?: PartialMessage<ResolveCaseRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -645,6 +1487,8 @@ export class ResolveCaseRequest extends Message<ResolveCaseRequest> {
     { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "decision", kind: "enum", T: proto3.getEnumType(Decision) },
+    { no: 4, name: "flagged_fields", kind: "message", T: FlaggedField, repeated: true },
+    { no: 5, name: "audio_format", kind: "enum", T: proto3.getEnumType(AudioFormat) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveCaseRequest {
@@ -665,30 +1509,77 @@ export class ResolveCaseRequest extends Message<ResolveCaseRequest> {
 }
 
 /**
- * @generated from message game.v1.ResolveCaseResponse
+ * @generated from message game.v1.FlaggedField
  */
-export class ResolveCaseResponse extends Message<ResolveCaseResponse> {
-  /**
-   * @generated from field: bool correct = 1;
-   */
-  correct = false;
-
+export class FlaggedField extends Message<FlaggedField> {
   /**
-   * Explanation of what was right/wrong
+   * "employee_badge" or "clearance_form"
    *
-   * @generated from field: string verdict = 2;
+   * @generated from field: string document_type = 1;
    */
-  verdict = "";
+  documentType = "";
 
   /**
-   * What player should have caught
+   * e.g. "cargo1", "expire_date"
    *
-   * @generated from field: repeated string contradictions_found = 3;
+   * @generated from field: string field = 2;
    */
-  contradictionsFound: string[] = [];
+  field = "";
 
-  /**
-   * Points earned/lost
+  constructor(data?: PartialMessage<FlaggedField>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.FlaggedField";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "document_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FlaggedField {
+    return new FlaggedField().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FlaggedField {
+    return new FlaggedField().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FlaggedField {
+    return new FlaggedField().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FlaggedField | PlainMessage<FlaggedField> | undefined, b: FlaggedField | PlainMessage<FlaggedField> | undefined): boolean {
+    return proto3.util.equals(FlaggedField, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.ResolveCaseResponse
+ */
+export class ResolveCaseResponse extends Message<ResolveCaseResponse> {
+  /**
+   * @generated from field: bool correct = 1;
+   */
+  correct = false;
+
+  /**
+   * Explanation of what was right/wrong
+   *
+   * @generated from field: string verdict = 2;
+   */
+  verdict = "";
+
+  /**
+   * What player should have caught
+   *
+   * @generated from field: repeated string contradictions_found = 3;
+   */
+  contradictionsFound: string[] = [];
+
+  /**
+   * Points earned/lost
    *
    * @generated from field: int32 score_delta = 4;
    */
@@ -718,6 +1609,62 @@ export class ResolveCaseResponse extends Message<ResolveCaseResponse> {
    */
   npcReactionAudio = new Uint8Array(0);
 
+  /**
+   * Included in score_delta: bonus for quick, penalty for slow decisions
+   *
+   * @generated from field: int32 time_bonus = 9;
+   */
+  timeBonus = 0;
+
+  /**
+   * Time from serving the case to the decision
+   *
+   * @generated from field: int32 decision_seconds = 10;
+   */
+  decisionSeconds = 0;
+
+  /**
+   * Itemized score_delta
+   *
+   * @generated from field: repeated game.v1.ScoreItem score_breakdown = 11;
+   */
+  scoreBreakdown: ScoreItem[] = [];
+
+  /**
+   * Consecutive correct decisions including this one
+   *
+   * @generated from field: int32 streak = 12;
+   */
+  streak = 0;
+
+  /**
+   * Citations issued this shift
+   *
+   * @generated from field: int32 citations = 13;
+   */
+  citations = 0;
+
+  /**
+   * Word timings for npc_reaction_audio
+   *
+   * @generated from field: game.v1.SubtitleTrack reaction_subtitles = 14;
+   */
+  reactionSubtitles?: SubtitleTrack;
+
+  /**
+   * e.g. "audio/mpeg"
+   *
+   * @generated from field: string npc_reaction_audio_mime_type = 15;
+   */
+  npcReactionAudioMimeType = "";
+
+  /**
+   * The verdict or reaction is a fallback line, or the reaction audio failed
+   *
+   * @generated from field: bool degraded = 16;
+   */
+  degraded = false;
+
   constructor(data?: PartialMessage<ResolveCaseResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -734,6 +1681,14 @@ export class ResolveCaseResponse extends Message<ResolveCaseResponse> {
     { no: 6, name: "outcome", kind: "enum", T: proto3.getEnumType(CaseOutcome) },
     { no: 7, name: "npc_reaction_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "npc_reaction_audio", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 9, name: "time_bonus", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "decision_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "score_breakdown", kind: "message", T: ScoreItem, repeated: true },
+    { no: 12, name: "streak", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 13, name: "citations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 14, name: "reaction_subtitles", kind: "message", T: SubtitleTrack },
+    { no: 15, name: "npc_reaction_audio_mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "degraded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveCaseResponse {
@@ -753,6 +1708,59 @@ export class ResolveCaseResponse extends Message<ResolveCaseResponse> {
   }
 }
 
+/**
+ * @generated from message game.v1.ScoreItem
+ */
+export class ScoreItem extends Message<ScoreItem> {
+  /**
+   * "outcome", "streak", "speed", "citation", "secondary_checks", "flags"
+   *
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * Player-facing explanation
+   *
+   * @generated from field: string label = 2;
+   */
+  label = "";
+
+  /**
+   * @generated from field: int32 points = 3;
+   */
+  points = 0;
+
+  constructor(data?: PartialMessage<ScoreItem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ScoreItem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScoreItem {
+    return new ScoreItem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScoreItem {
+    return new ScoreItem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScoreItem {
+    return new ScoreItem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScoreItem | PlainMessage<ScoreItem> | undefined, b: ScoreItem | PlainMessage<ScoreItem> | undefined): boolean {
+    return proto3.util.equals(ScoreItem, a, b);
+  }
+}
+
 /**
  * @generated from message game.v1.GetSessionStatusRequest
  */
@@ -777,89 +1785,946 @@ export class GetSessionStatusRequest extends Message<GetSessionStatusRequest> {
     return new GetSessionStatusRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSessionStatusRequest {
-    return new GetSessionStatusRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSessionStatusRequest {
+    return new GetSessionStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSessionStatusRequest {
+    return new GetSessionStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSessionStatusRequest | PlainMessage<GetSessionStatusRequest> | undefined, b: GetSessionStatusRequest | PlainMessage<GetSessionStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetSessionStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetSessionStatusResponse
+ */
+export class GetSessionStatusResponse extends Message<GetSessionStatusResponse> {
+  /**
+   * @generated from field: int32 cases_completed = 1;
+   */
+  casesCompleted = 0;
+
+  /**
+   * @generated from field: int32 total_cases = 2;
+   */
+  totalCases = 0;
+
+  /**
+   * @generated from field: int32 total_score = 3;
+   */
+  totalScore = 0;
+
+  /**
+   * @generated from field: int32 correct_decisions = 4;
+   */
+  correctDecisions = 0;
+
+  /**
+   * @generated from field: int32 incorrect_decisions = 5;
+   */
+  incorrectDecisions = 0;
+
+  /**
+   * @generated from field: int32 remaining_secondary_checks = 6;
+   */
+  remainingSecondaryChecks = 0;
+
+  /**
+   * @generated from field: bool session_complete = 7;
+   */
+  sessionComplete = false;
+
+  /**
+   * 0 once the shuttle has departed
+   *
+   * @generated from field: int32 remaining_shift_seconds = 8;
+   */
+  remainingShiftSeconds = 0;
+
+  /**
+   * In-game time, e.g. "14:35"
+   *
+   * @generated from field: string shift_clock = 9;
+   */
+  shiftClock = "";
+
+  /**
+   * @generated from field: bool shuttle_departed = 10;
+   */
+  shuttleDeparted = false;
+
+  constructor(data?: PartialMessage<GetSessionStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetSessionStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cases_completed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "total_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "total_score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "correct_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "incorrect_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "remaining_secondary_checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "session_complete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "remaining_shift_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "shift_clock", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "shuttle_departed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSessionStatusResponse {
+    return new GetSessionStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSessionStatusResponse {
+    return new GetSessionStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSessionStatusResponse {
+    return new GetSessionStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSessionStatusResponse | PlainMessage<GetSessionStatusResponse> | undefined, b: GetSessionStatusResponse | PlainMessage<GetSessionStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetSessionStatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetCampaignRequest
+ */
+export class GetCampaignRequest extends Message<GetCampaignRequest> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId = "";
+
+  constructor(data?: PartialMessage<GetCampaignRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetCampaignRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCampaignRequest {
+    return new GetCampaignRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCampaignRequest {
+    return new GetCampaignRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCampaignRequest {
+    return new GetCampaignRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCampaignRequest | PlainMessage<GetCampaignRequest> | undefined, b: GetCampaignRequest | PlainMessage<GetCampaignRequest> | undefined): boolean {
+    return proto3.util.equals(GetCampaignRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetCampaignResponse
+ */
+export class GetCampaignResponse extends Message<GetCampaignResponse> {
+  /**
+   * Last started campaign day, 0 if none
+   *
+   * @generated from field: int32 day = 1;
+   */
+  day = 0;
+
+  /**
+   * @generated from field: string game_date = 2;
+   */
+  gameDate = "";
+
+  /**
+   * Rules in force on the current day
+   *
+   * @generated from field: repeated string rules = 3;
+   */
+  rules: string[] = [];
+
+  /**
+   * @generated from field: repeated game.v1.CampaignDay days = 4;
+   */
+  days: CampaignDay[] = [];
+
+  constructor(data?: PartialMessage<GetCampaignResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetCampaignResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "day", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "game_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "days", kind: "message", T: CampaignDay, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCampaignResponse {
+    return new GetCampaignResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCampaignResponse {
+    return new GetCampaignResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCampaignResponse {
+    return new GetCampaignResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCampaignResponse | PlainMessage<GetCampaignResponse> | undefined, b: GetCampaignResponse | PlainMessage<GetCampaignResponse> | undefined): boolean {
+    return proto3.util.equals(GetCampaignResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.CampaignDay
+ */
+export class CampaignDay extends Message<CampaignDay> {
+  /**
+   * @generated from field: int32 day = 1;
+   */
+  day = 0;
+
+  /**
+   * @generated from field: string game_date = 2;
+   */
+  gameDate = "";
+
+  /**
+   * @generated from field: repeated string rules = 3;
+   */
+  rules: string[] = [];
+
+  /**
+   * @generated from field: string story_event = 4;
+   */
+  storyEvent = "";
+
+  /**
+   * @generated from field: string session_id = 5;
+   */
+  sessionId = "";
+
+  constructor(data?: PartialMessage<CampaignDay>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.CampaignDay";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "day", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "game_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "story_event", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CampaignDay {
+    return new CampaignDay().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CampaignDay {
+    return new CampaignDay().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CampaignDay {
+    return new CampaignDay().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CampaignDay | PlainMessage<CampaignDay> | undefined, b: CampaignDay | PlainMessage<CampaignDay> | undefined): boolean {
+    return proto3.util.equals(CampaignDay, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetShiftReportRequest
+ */
+export class GetShiftReportRequest extends Message<GetShiftReportRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * Ask the supervisor to write a summary of weak spots
+   *
+   * @generated from field: bool include_summary = 2;
+   */
+  includeSummary = false;
+
+  constructor(data?: PartialMessage<GetShiftReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetShiftReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_summary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetShiftReportRequest {
+    return new GetShiftReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetShiftReportRequest {
+    return new GetShiftReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetShiftReportRequest {
+    return new GetShiftReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetShiftReportRequest | PlainMessage<GetShiftReportRequest> | undefined, b: GetShiftReportRequest | PlainMessage<GetShiftReportRequest> | undefined): boolean {
+    return proto3.util.equals(GetShiftReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetShiftReportResponse
+ */
+export class GetShiftReportResponse extends Message<GetShiftReportResponse> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string game_date = 2;
+   */
+  gameDate = "";
+
+  /**
+   * @generated from field: int32 total_score = 3;
+   */
+  totalScore = 0;
+
+  /**
+   * @generated from field: int32 correct_decisions = 4;
+   */
+  correctDecisions = 0;
+
+  /**
+   * @generated from field: int32 incorrect_decisions = 5;
+   */
+  incorrectDecisions = 0;
+
+  /**
+   * @generated from field: int32 citations = 6;
+   */
+  citations = 0;
+
+  /**
+   * @generated from field: int32 total_cases = 7;
+   */
+  totalCases = 0;
+
+  /**
+   * Cases that were served, in order
+   *
+   * @generated from field: repeated game.v1.CaseDebrief cases = 8;
+   */
+  cases: CaseDebrief[] = [];
+
+  /**
+   * Empty unless include_summary was set
+   *
+   * @generated from field: string supervisor_summary = 9;
+   */
+  supervisorSummary = "";
+
+  constructor(data?: PartialMessage<GetShiftReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetShiftReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "game_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "total_score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "correct_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "incorrect_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "citations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "total_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "cases", kind: "message", T: CaseDebrief, repeated: true },
+    { no: 9, name: "supervisor_summary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetShiftReportResponse {
+    return new GetShiftReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetShiftReportResponse {
+    return new GetShiftReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetShiftReportResponse {
+    return new GetShiftReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetShiftReportResponse | PlainMessage<GetShiftReportResponse> | undefined, b: GetShiftReportResponse | PlainMessage<GetShiftReportResponse> | undefined): boolean {
+    return proto3.util.equals(GetShiftReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.CaseDebrief
+ */
+export class CaseDebrief extends Message<CaseDebrief> {
+  /**
+   * @generated from field: string case_id = 1;
+   */
+  caseId = "";
+
+  /**
+   * @generated from field: string npc_name = 2;
+   */
+  npcName = "";
+
+  /**
+   * UNSPECIFIED if the case was never decided
+   *
+   * @generated from field: game.v1.Decision player_decision = 3;
+   */
+  playerDecision = Decision.UNSPECIFIED;
+
+  /**
+   * @generated from field: game.v1.Decision correct_decision = 4;
+   */
+  correctDecision = Decision.UNSPECIFIED;
+
+  /**
+   * @generated from field: bool correct = 5;
+   */
+  correct = false;
+
+  /**
+   * @generated from field: repeated game.v1.Violation caught_violations = 6;
+   */
+  caughtViolations: Violation[] = [];
+
+  /**
+   * @generated from field: repeated game.v1.Violation missed_violations = 7;
+   */
+  missedViolations: Violation[] = [];
+
+  /**
+   * @generated from field: repeated string questions_asked = 8;
+   */
+  questionsAsked: string[] = [];
+
+  /**
+   * @generated from field: int32 secondary_checks_used = 9;
+   */
+  secondaryChecksUsed = 0;
+
+  /**
+   * Time from serving the case to the decision
+   *
+   * @generated from field: int32 decision_seconds = 10;
+   */
+  decisionSeconds = 0;
+
+  constructor(data?: PartialMessage<CaseDebrief>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.CaseDebrief";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "npc_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "player_decision", kind: "enum", T: proto3.getEnumType(Decision) },
+    { no: 4, name: "correct_decision", kind: "enum", T: proto3.getEnumType(Decision) },
+    { no: 5, name: "correct", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "caught_violations", kind: "message", T: Violation, repeated: true },
+    { no: 7, name: "missed_violations", kind: "message", T: Violation, repeated: true },
+    { no: 8, name: "questions_asked", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "secondary_checks_used", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "decision_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CaseDebrief {
+    return new CaseDebrief().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CaseDebrief {
+    return new CaseDebrief().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CaseDebrief {
+    return new CaseDebrief().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CaseDebrief | PlainMessage<CaseDebrief> | undefined, b: CaseDebrief | PlainMessage<CaseDebrief> | undefined): boolean {
+    return proto3.util.equals(CaseDebrief, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.Violation
+ */
+export class Violation extends Message<Violation> {
+  /**
+   * "employee_badge" or "clearance_form"
+   *
+   * @generated from field: string document_type = 1;
+   */
+  documentType = "";
+
+  /**
+   * @generated from field: string field = 2;
+   */
+  field = "";
+
+  /**
+   * The rule that was broken
+   *
+   * @generated from field: string rule = 3;
+   */
+  rule = "";
+
+  constructor(data?: PartialMessage<Violation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.Violation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "document_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Violation {
+    return new Violation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Violation {
+    return new Violation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Violation {
+    return new Violation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Violation | PlainMessage<Violation> | undefined, b: Violation | PlainMessage<Violation> | undefined): boolean {
+    return proto3.util.equals(Violation, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.ResumeSessionRequest
+ */
+export class ResumeSessionRequest extends Message<ResumeSessionRequest> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * Must match the player_id the session was started with
+   *
+   * @generated from field: string player_id = 2;
+   */
+  playerId = "";
+
+  /**
+   * Preferred codec for the current case's opening audio
+   *
+   * @generated from field: game.v1.AudioFormat audio_format = 3;
+   */
+  audioFormat = AudioFormat.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ResumeSessionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ResumeSessionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "audio_format", kind: "enum", T: proto3.getEnumType(AudioFormat) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeSessionRequest {
+    return new ResumeSessionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeSessionRequest {
+    return new ResumeSessionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeSessionRequest {
+    return new ResumeSessionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeSessionRequest | PlainMessage<ResumeSessionRequest> | undefined, b: ResumeSessionRequest | PlainMessage<ResumeSessionRequest> | undefined): boolean {
+    return proto3.util.equals(ResumeSessionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.ResumeSessionResponse
+ */
+export class ResumeSessionResponse extends Message<ResumeSessionResponse> {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * @generated from field: string game_date = 2;
+   */
+  gameDate = "";
+
+  /**
+   * @generated from field: repeated string rules = 3;
+   */
+  rules: string[] = [];
+
+  /**
+   * Unset when no case is waiting
+   *
+   * @generated from field: game.v1.GetNextCaseResponse current_case = 4;
+   */
+  currentCase?: GetNextCaseResponse;
+
+  /**
+   * Questions and answers on the current case so far
+   *
+   * @generated from field: repeated game.v1.DialogueLine transcript = 5;
+   */
+  transcript: DialogueLine[] = [];
+
+  /**
+   * @generated from field: int32 total_score = 6;
+   */
+  totalScore = 0;
+
+  /**
+   * @generated from field: int32 correct_decisions = 7;
+   */
+  correctDecisions = 0;
+
+  /**
+   * @generated from field: int32 incorrect_decisions = 8;
+   */
+  incorrectDecisions = 0;
+
+  /**
+   * @generated from field: int32 remaining_secondary_checks = 9;
+   */
+  remainingSecondaryChecks = 0;
+
+  /**
+   * @generated from field: int32 cases_completed = 10;
+   */
+  casesCompleted = 0;
+
+  /**
+   * @generated from field: int32 total_cases = 11;
+   */
+  totalCases = 0;
+
+  /**
+   * @generated from field: bool session_complete = 12;
+   */
+  sessionComplete = false;
+
+  /**
+   * 0 for standalone shifts
+   *
+   * @generated from field: int32 campaign_day = 13;
+   */
+  campaignDay = 0;
+
+  /**
+   * Today's campaign story event
+   *
+   * @generated from field: string story_event = 14;
+   */
+  storyEvent = "";
+
+  /**
+   * @generated from field: int32 remaining_shift_seconds = 15;
+   */
+  remainingShiftSeconds = 0;
+
+  /**
+   * In-game time, e.g. "14:35"
+   *
+   * @generated from field: string shift_clock = 16;
+   */
+  shiftClock = "";
+
+  constructor(data?: PartialMessage<ResumeSessionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ResumeSessionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "game_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "current_case", kind: "message", T: GetNextCaseResponse },
+    { no: 5, name: "transcript", kind: "message", T: DialogueLine, repeated: true },
+    { no: 6, name: "total_score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "correct_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "incorrect_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "remaining_secondary_checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "cases_completed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "total_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "session_complete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "campaign_day", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 14, name: "story_event", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "remaining_shift_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 16, name: "shift_clock", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeSessionResponse {
+    return new ResumeSessionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeSessionResponse {
+    return new ResumeSessionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeSessionResponse {
+    return new ResumeSessionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeSessionResponse | PlainMessage<ResumeSessionResponse> | undefined, b: ResumeSessionResponse | PlainMessage<ResumeSessionResponse> | undefined): boolean {
+    return proto3.util.equals(ResumeSessionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.DialogueLine
+ */
+export class DialogueLine extends Message<DialogueLine> {
+  /**
+   * @generated from field: string question = 1;
+   */
+  question = "";
+
+  /**
+   * @generated from field: string answer = 2;
+   */
+  answer = "";
+
+  constructor(data?: PartialMessage<DialogueLine>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.DialogueLine";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "question", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "answer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DialogueLine {
+    return new DialogueLine().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DialogueLine {
+    return new DialogueLine().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DialogueLine {
+    return new DialogueLine().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DialogueLine | PlainMessage<DialogueLine> | undefined, b: DialogueLine | PlainMessage<DialogueLine> | undefined): boolean {
+    return proto3.util.equals(DialogueLine, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.ListMySessionsRequest
+ */
+export class ListMySessionsRequest extends Message<ListMySessionsRequest> {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId = "";
+
+  /**
+   * Only sessions that can still be resumed
+   *
+   * @generated from field: bool unfinished_only = 2;
+   */
+  unfinishedOnly = false;
+
+  constructor(data?: PartialMessage<ListMySessionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ListMySessionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "unfinished_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMySessionsRequest {
+    return new ListMySessionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMySessionsRequest {
+    return new ListMySessionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMySessionsRequest {
+    return new ListMySessionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMySessionsRequest | PlainMessage<ListMySessionsRequest> | undefined, b: ListMySessionsRequest | PlainMessage<ListMySessionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListMySessionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.ListMySessionsResponse
+ */
+export class ListMySessionsResponse extends Message<ListMySessionsResponse> {
+  /**
+   * @generated from field: repeated game.v1.SessionSummary sessions = 1;
+   */
+  sessions: SessionSummary[] = [];
+
+  constructor(data?: PartialMessage<ListMySessionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ListMySessionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sessions", kind: "message", T: SessionSummary, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMySessionsResponse {
+    return new ListMySessionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMySessionsResponse {
+    return new ListMySessionsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSessionStatusRequest {
-    return new GetSessionStatusRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMySessionsResponse {
+    return new ListMySessionsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSessionStatusRequest | PlainMessage<GetSessionStatusRequest> | undefined, b: GetSessionStatusRequest | PlainMessage<GetSessionStatusRequest> | undefined): boolean {
-    return proto3.util.equals(GetSessionStatusRequest, a, b);
+  static equals(a: ListMySessionsResponse | PlainMessage<ListMySessionsResponse> | undefined, b: ListMySessionsResponse | PlainMessage<ListMySessionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListMySessionsResponse, a, b);
   }
 }
 
 /**
- * @generated from message game.v1.GetSessionStatusResponse
+ * @generated from message game.v1.SessionSummary
  */
-export class GetSessionStatusResponse extends Message<GetSessionStatusResponse> {
+export class SessionSummary extends Message<SessionSummary> {
   /**
-   * @generated from field: int32 cases_completed = 1;
+   * @generated from field: string session_id = 1;
    */
-  casesCompleted = 0;
+  sessionId = "";
 
   /**
-   * @generated from field: int32 total_cases = 2;
+   * @generated from field: string game_date = 2;
    */
-  totalCases = 0;
+  gameDate = "";
 
   /**
-   * @generated from field: int32 total_score = 3;
+   * @generated from field: int32 campaign_day = 3;
    */
-  totalScore = 0;
+  campaignDay = 0;
 
   /**
-   * @generated from field: int32 correct_decisions = 4;
+   * @generated from field: int32 cases_completed = 4;
    */
-  correctDecisions = 0;
+  casesCompleted = 0;
 
   /**
-   * @generated from field: int32 incorrect_decisions = 5;
+   * @generated from field: int32 total_cases = 5;
    */
-  incorrectDecisions = 0;
+  totalCases = 0;
 
   /**
-   * @generated from field: int32 remaining_secondary_checks = 6;
+   * @generated from field: int32 total_score = 6;
    */
-  remainingSecondaryChecks = 0;
+  totalScore = 0;
 
   /**
    * @generated from field: bool session_complete = 7;
    */
   sessionComplete = false;
 
-  constructor(data?: PartialMessage<GetSessionStatusResponse>) {
+  /**
+   * Unix seconds
+   *
+   * @generated from field: int64 started_at = 8;
+   */
+  startedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SessionSummary>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "game.v1.GetSessionStatusResponse";
+  static readonly typeName = "game.v1.SessionSummary";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "cases_completed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "total_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "total_score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "correct_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "incorrect_decisions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 6, name: "remaining_secondary_checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "game_date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "campaign_day", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "cases_completed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "total_cases", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "total_score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 7, name: "session_complete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "started_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSessionStatusResponse {
-    return new GetSessionStatusResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SessionSummary {
+    return new SessionSummary().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSessionStatusResponse {
-    return new GetSessionStatusResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SessionSummary {
+    return new SessionSummary().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSessionStatusResponse {
-    return new GetSessionStatusResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SessionSummary {
+    return new SessionSummary().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSessionStatusResponse | PlainMessage<GetSessionStatusResponse> | undefined, b: GetSessionStatusResponse | PlainMessage<GetSessionStatusResponse> | undefined): boolean {
-    return proto3.util.equals(GetSessionStatusResponse, a, b);
+  static equals(a: SessionSummary | PlainMessage<SessionSummary> | undefined, b: SessionSummary | PlainMessage<SessionSummary> | undefined): boolean {
+    return proto3.util.equals(SessionSummary, a, b);
   }
 }
 
@@ -905,6 +2770,20 @@ export class NPCProfile extends Message<NPCProfile> {
    */
   demeanor = "";
 
+  /**
+   * Stable roster ID for recurring workers
+   *
+   * @generated from field: string npc_id = 7;
+   */
+  npcId = "";
+
+  /**
+   * Times this worker has met the player before
+   *
+   * @generated from field: int32 previous_encounters = 8;
+   */
+  previousEncounters = 0;
+
   constructor(data?: PartialMessage<NPCProfile>) {
     super();
     proto3.util.initPartial(data, this);
@@ -919,6 +2798,8 @@ export class NPCProfile extends Message<NPCProfile> {
     { no: 4, name: "personality", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "portrait_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "demeanor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "npc_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "previous_encounters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NPCProfile {
@@ -993,3 +2874,349 @@ export class Document extends Message<Document> {
   }
 }
 
+/**
+ * @generated from message game.v1.ListVoicesRequest
+ */
+export class ListVoicesRequest extends Message<ListVoicesRequest> {
+  /**
+   * @generated from field: bool include_disabled = 1;
+   */
+  includeDisabled = false;
+
+  constructor(data?: PartialMessage<ListVoicesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ListVoicesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "include_disabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListVoicesRequest {
+    return new ListVoicesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListVoicesRequest {
+    return new ListVoicesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListVoicesRequest {
+    return new ListVoicesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListVoicesRequest | PlainMessage<ListVoicesRequest> | undefined, b: ListVoicesRequest | PlainMessage<ListVoicesRequest> | undefined): boolean {
+    return proto3.util.equals(ListVoicesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.ListVoicesResponse
+ */
+export class ListVoicesResponse extends Message<ListVoicesResponse> {
+  /**
+   * @generated from field: repeated game.v1.VoiceInfo voices = 1;
+   */
+  voices: VoiceInfo[] = [];
+
+  constructor(data?: PartialMessage<ListVoicesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.ListVoicesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "voices", kind: "message", T: VoiceInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListVoicesResponse {
+    return new ListVoicesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListVoicesResponse {
+    return new ListVoicesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListVoicesResponse {
+    return new ListVoicesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListVoicesResponse | PlainMessage<ListVoicesResponse> | undefined, b: ListVoicesResponse | PlainMessage<ListVoicesResponse> | undefined): boolean {
+    return proto3.util.equals(ListVoicesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.VoiceInfo
+ */
+export class VoiceInfo extends Message<VoiceInfo> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * @generated from field: bool enabled = 4;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: string vocal_age = 5;
+   */
+  vocalAge = "";
+
+  /**
+   * @generated from field: string pitch = 6;
+   */
+  pitch = "";
+
+  /**
+   * @generated from field: repeated string timbres = 7;
+   */
+  timbres: string[] = [];
+
+  /**
+   * @generated from field: string accent = 8;
+   */
+  accent = "";
+
+  /**
+   * @generated from field: string energy = 9;
+   */
+  energy = "";
+
+  /**
+   * @generated from field: repeated string languages = 10;
+   */
+  languages: string[] = [];
+
+  /**
+   * Emotions with voice setting overrides
+   *
+   * @generated from field: repeated string emotion_overrides = 11;
+   */
+  emotionOverrides: string[] = [];
+
+  constructor(data?: PartialMessage<VoiceInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.VoiceInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "vocal_age", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "pitch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "timbres", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "accent", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "energy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "languages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "emotion_overrides", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoiceInfo {
+    return new VoiceInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoiceInfo {
+    return new VoiceInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoiceInfo {
+    return new VoiceInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoiceInfo | PlainMessage<VoiceInfo> | undefined, b: VoiceInfo | PlainMessage<VoiceInfo> | undefined): boolean {
+    return proto3.util.equals(VoiceInfo, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetUsageRequest
+ */
+export class GetUsageRequest extends Message<GetUsageRequest> {
+  /**
+   * Optional
+   *
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * Optional
+   *
+   * @generated from field: string player_id = 2;
+   */
+  playerId = "";
+
+  constructor(data?: PartialMessage<GetUsageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetUsageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "player_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsageRequest {
+    return new GetUsageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUsageRequest {
+    return new GetUsageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUsageRequest {
+    return new GetUsageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUsageRequest | PlainMessage<GetUsageRequest> | undefined, b: GetUsageRequest | PlainMessage<GetUsageRequest> | undefined): boolean {
+    return proto3.util.equals(GetUsageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.GetUsageResponse
+ */
+export class GetUsageResponse extends Message<GetUsageResponse> {
+  /**
+   * Since the server started
+   *
+   * @generated from field: game.v1.UsageTotals server = 1;
+   */
+  server?: UsageTotals;
+
+  /**
+   * Unset unless session_id was given
+   *
+   * @generated from field: game.v1.UsageTotals session = 2;
+   */
+  session?: UsageTotals;
+
+  /**
+   * Unset unless player_id was given
+   *
+   * @generated from field: game.v1.UsageTotals player = 3;
+   */
+  player?: UsageTotals;
+
+  constructor(data?: PartialMessage<GetUsageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.GetUsageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "server", kind: "message", T: UsageTotals },
+    { no: 2, name: "session", kind: "message", T: UsageTotals },
+    { no: 3, name: "player", kind: "message", T: UsageTotals },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUsageResponse {
+    return new GetUsageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUsageResponse {
+    return new GetUsageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUsageResponse {
+    return new GetUsageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUsageResponse | PlainMessage<GetUsageResponse> | undefined, b: GetUsageResponse | PlainMessage<GetUsageResponse> | undefined): boolean {
+    return proto3.util.equals(GetUsageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message game.v1.UsageTotals
+ */
+export class UsageTotals extends Message<UsageTotals> {
+  /**
+   * @generated from field: int64 llm_calls = 1;
+   */
+  llmCalls = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 prompt_tokens = 2;
+   */
+  promptTokens = protoInt64.zero;
+
+  /**
+   * Includes thinking tokens
+   *
+   * @generated from field: int64 output_tokens = 3;
+   */
+  outputTokens = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 tts_calls = 4;
+   */
+  ttsCalls = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 tts_characters = 5;
+   */
+  ttsCharacters = protoInt64.zero;
+
+  /**
+   * From the configured prices
+   *
+   * @generated from field: double estimated_cost_usd = 6;
+   */
+  estimatedCostUsd = 0;
+
+  constructor(data?: PartialMessage<UsageTotals>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "game.v1.UsageTotals";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "llm_calls", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "prompt_tokens", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "output_tokens", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "tts_calls", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "tts_characters", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "estimated_cost_usd", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UsageTotals {
+    return new UsageTotals().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UsageTotals {
+    return new UsageTotals().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UsageTotals {
+    return new UsageTotals().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UsageTotals | PlainMessage<UsageTotals> | undefined, b: UsageTotals | PlainMessage<UsageTotals> | undefined): boolean {
+    return proto3.util.equals(UsageTotals, a, b);
+  }
+}
+